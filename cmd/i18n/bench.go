@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+// BenchHistoryFileName is where "i18n bench" keeps its results (in the cache directory) unless told otherwise
+const BenchHistoryFileName = "bench.json"
+
+// benchRun is one invocation of "i18n bench"
+type benchRun struct {
+	Time      time.Time                  `json:"time"`
+	GoVersion string                     `json:"go_version"`
+	Kind      input.Kind                 `json:"kind"`
+	Results   map[int]solver.BenchResult `json:"results"`
+}
+
+// benchHistory is every recorded run, oldest first
+type benchHistory struct {
+	Runs []benchRun `json:"runs"`
+}
+
+// previous finds the most recent result for a puzzle on the same kind of input
+func (h *benchHistory) previous(num int, kind input.Kind) (solver.BenchResult, time.Time, bool) {
+	for i := len(h.Runs) - 1; i >= 0; i-- {
+		run := h.Runs[i]
+		if run.Kind != kind {
+			continue
+		}
+		if res, ok := run.Results[num]; ok {
+			return res, run.Time, true
+		}
+	}
+
+	return solver.BenchResult{}, time.Time{}, false
+}
+
+func readBenchHistory(path string) (*benchHistory, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &benchHistory{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read benchmark history: %w", err)
+	}
+
+	var h benchHistory
+	if err := json.Unmarshal(data, &h); err != nil {
+		return nil, fmt.Errorf("could not parse benchmark history %s: %w", path, err)
+	}
+
+	return &h, nil
+}
+
+func writeBenchHistory(path string, h *benchHistory) error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// an interrupted write shouldn't lose the history
+	err = input.WriteFileAtomic(path, data, 0664)
+	if err != nil {
+		return fmt.Errorf("could not write benchmark history: %w", err)
+	}
+
+	return nil
+}
+
+// regressions describes every way curr is worse than prev by more than threshold (0.1 being 10%)
+func regressions(prev, curr solver.BenchResult, threshold float64) []string {
+	var ret []string
+
+	check := func(what string, before, after int64) {
+		if after <= before {
+			return
+		}
+		if before == 0 {
+			ret = append(ret, fmt.Sprintf("%s up from 0 to %d", what, after))
+			return
+		}
+		change := float64(after-before) / float64(before)
+		if change > threshold {
+			ret = append(ret, fmt.Sprintf("%s up %.0f%%", what, change*100))
+		}
+	}
+
+	check("time/op", prev.NsPerOp, curr.NsPerOp)
+	check("allocs/op", prev.AllocsPerOp, curr.AllocsPerOp)
+
+	return ret
+}
+
+func benchCommand(ctx context.Context, args []string) error {
+	fs := newFlagSet("bench")
+	test := fs.Bool("test", false, "use the test input instead of the real input")
+	count := fs.Int("n", 5, "number of timed runs per puzzle")
+	historyFlag := fs.String("history", "", "file to record results in and compare against (defaults to "+BenchHistoryFileName+" in the cache directory)")
+	threshold := fs.Float64("threshold", 0.1, "how much slower (or how many more allocations), as a fraction, counts as a regression")
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *count < 1 {
+		return fmt.Errorf("need at least one run, got %d", *count)
+	}
+	if *threshold < 0 {
+		return fmt.Errorf("the regression threshold can't be negative, got %g", *threshold)
+	}
+
+	puzzles, err := selectPuzzles(positional)
+	if err != nil {
+		return err
+	}
+
+	kind := kindFromFlag(*test)
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	ctx = solver.WithLogger(ctx, logger())
+
+	historyFile := *historyFlag
+	if historyFile == "" {
+		historyFile = filepath.Join(c.CacheDir(), BenchHistoryFileName)
+	}
+	history, err := readBenchHistory(historyFile)
+	if err != nil {
+		return err
+	}
+
+	run := benchRun{
+		Time:      time.Now().UTC(),
+		GoVersion: runtime.Version(),
+		Kind:      kind,
+		Results:   map[int]solver.BenchResult{},
+	}
+
+	failed := 0
+	regressed := 0
+	for _, p := range puzzles {
+		in, err := c.GetInputBytes(ctx, p.Number, kind)
+		if err != nil {
+			printFailure(fmt.Sprintf("puzzle %d failed", p.Number), err)
+			failed++
+			continue
+		}
+
+		res, err := p.Bench(ctx, in, *count)
+		if err != nil {
+			printFailure(fmt.Sprintf("puzzle %d failed", p.Number), err)
+			failed++
+			continue
+		}
+		run.Results[p.Number] = res
+
+		fmt.Printf("puzzle %2d (%s): %d runs, %s/op, %d allocs/op, %d B/op (min %s, max %s)\n", p.Number, p.Name, res.Runs, time.Duration(res.NsPerOp), res.AllocsPerOp, res.BytesPerOp, res.Fastest, res.Slowest)
+
+		prev, when, ok := history.previous(p.Number, kind)
+		if !ok {
+			continue
+		}
+		worse := regressions(prev, res, *threshold)
+		for _, curr := range worse {
+			fmt.Printf("          REGRESSION: %s since %s\n", curr, when.Local().Format(time.DateTime))
+		}
+		if len(worse) > 0 {
+			regressed++
+		}
+	}
+
+	if len(run.Results) > 0 {
+		history.Runs = append(history.Runs, run)
+		if err := writeBenchHistory(historyFile, history); err != nil {
+			return err
+		}
+	}
+
+	if err := failures(failed, len(puzzles)); err != nil {
+		return err
+	}
+	if regressed > 0 {
+		return fmt.Errorf("%d regressions", regressed)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+func Test_benchHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), BenchHistoryFileName)
+
+	h, err := readBenchHistory(path)
+	require.NoError(t, err)
+	assert.Empty(t, h.Runs)
+
+	first := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+	h.Runs = append(h.Runs,
+		benchRun{Time: first, Kind: input.RealInput, Results: map[int]solver.BenchResult{1: {Runs: 5, NsPerOp: 100}, 2: {Runs: 5, NsPerOp: 200}}},
+		benchRun{Time: second, Kind: input.RealInput, Results: map[int]solver.BenchResult{1: {Runs: 5, NsPerOp: 150}}},
+		benchRun{Time: second, Kind: input.TestInput, Results: map[int]solver.BenchResult{2: {Runs: 5, NsPerOp: 10}}},
+	)
+	require.NoError(t, writeBenchHistory(path, h))
+
+	h, err = readBenchHistory(path)
+	require.NoError(t, err)
+	require.Len(t, h.Runs, 3)
+
+	res, when, ok := h.previous(1, input.RealInput)
+	assert.True(t, ok)
+	assert.Equal(t, int64(150), res.NsPerOp)
+	assert.Equal(t, second, when)
+
+	res, when, ok = h.previous(2, input.RealInput)
+	assert.True(t, ok)
+	assert.Equal(t, int64(200), res.NsPerOp)
+	assert.Equal(t, first, when)
+
+	_, _, ok = h.previous(1, input.TestInput)
+	assert.False(t, ok)
+}
+
+func Test_regressions(t *testing.T) {
+	prev := solver.BenchResult{NsPerOp: 1000, AllocsPerOp: 10}
+
+	assert.Empty(t, regressions(prev, solver.BenchResult{NsPerOp: 1050, AllocsPerOp: 10}, 0.1))
+	assert.Empty(t, regressions(prev, solver.BenchResult{NsPerOp: 500, AllocsPerOp: 2}, 0.1))
+	assert.Equal(t, []string{"time/op up 50%"}, regressions(prev, solver.BenchResult{NsPerOp: 1500, AllocsPerOp: 10}, 0.1))
+	assert.Equal(t, []string{"time/op up 20%", "allocs/op up 100%"}, regressions(prev, solver.BenchResult{NsPerOp: 1200, AllocsPerOp: 20}, 0.1))
+	assert.Equal(t, []string{"allocs/op up from 0 to 3"}, regressions(solver.BenchResult{NsPerOp: 1000}, solver.BenchResult{NsPerOp: 1000, AllocsPerOp: 3}, 0.1))
+}
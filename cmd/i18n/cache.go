@@ -0,0 +1,268 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lthummus/i18n-puzzles/input"
+)
+
+const cacheUsage = `usage: i18n cache <subcommand> [flags]
+
+subcommands:
+  list     show every cached input and whether it can be trusted
+  verify   check every cached input against its metadata
+  purge    remove cached inputs so they are downloaded again
+  export   bundle the cache (without the session token) in to a .tar.gz file
+  import   load a .tar.gz file made by export in to the cache
+`
+
+var cacheCommands = map[string]command{
+	"list":   cacheListCommand,
+	"verify": cacheVerifyCommand,
+	"purge":  cachePurgeCommand,
+	"export": cacheExportCommand,
+	"import": cacheImportCommand,
+}
+
+func cacheCommand(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, cacheUsage)
+		return flag.ErrHelp
+	}
+
+	cmd, ok := cacheCommands[args[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown cache subcommand: %s\n\n%s", args[0], cacheUsage)
+		return flag.ErrHelp
+	}
+
+	return cmd(ctx, args[1:])
+}
+
+// entryStatus is a short description of the state of a cache entry for display
+func entryStatus(e input.CacheEntry) string {
+	switch {
+	case e.Problem != nil:
+		return "BAD"
+	case e.Metadata == nil:
+		return "UNVERIFIED"
+	default:
+		return "OK"
+	}
+}
+
+func cacheListCommand(_ context.Context, args []string) error {
+	fs := newFlagSet("cache list")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	entries, err := c.Cache().Entries()
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		downloaded := "unknown"
+		if e.Metadata != nil {
+			downloaded = e.Metadata.DownloadedAt.Local().Format(time.DateTime)
+		}
+		fmt.Printf("puzzle %2d %-4s %8d bytes  downloaded %-19s  %s\n", e.Puzzle, e.Kind, e.Size, downloaded, entryStatus(e))
+	}
+
+	fmt.Printf("\n%d cached inputs in %s\n", len(entries), c.CacheDir())
+
+	return nil
+}
+
+func cacheVerifyCommand(_ context.Context, args []string) error {
+	fs := newFlagSet("cache verify")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	entries, err := c.Cache().Entries()
+	if err != nil {
+		return err
+	}
+
+	bad := 0
+	for _, e := range entries {
+		switch {
+		case e.Problem != nil:
+			fmt.Printf("puzzle %2d %-4s BAD: %s\n", e.Puzzle, e.Kind, e.Problem)
+			bad++
+		case e.Metadata == nil:
+			fmt.Printf("puzzle %2d %-4s UNVERIFIED: no metadata (cached by an older version)\n", e.Puzzle, e.Kind)
+		}
+	}
+
+	fmt.Printf("checked %d cached inputs, %d bad\n", len(entries), bad)
+
+	if bad > 0 {
+		return fmt.Errorf("%d bad cache entries (remove them with \"i18n cache purge -invalid\")", bad)
+	}
+
+	return nil
+}
+
+func cachePurgeCommand(_ context.Context, args []string) error {
+	fs := newFlagSet("cache purge")
+	kindFlag := fs.String("kind", "both", "which inputs to purge: real, test or both")
+	invalid := fs.Bool("invalid", false, "purge every entry that fails verification instead of specific puzzles")
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	cache := c.Cache()
+
+	var toPurge []input.CacheEntry
+	if *invalid {
+		if len(positional) > 0 {
+			return fmt.Errorf("give either -invalid or a list of puzzles, not both")
+		}
+
+		entries, err := cache.Entries()
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if e.Problem != nil {
+				toPurge = append(toPurge, e)
+			}
+		}
+	} else {
+		nums, err := parseSelection(positional)
+		if err != nil {
+			return err
+		}
+
+		kinds, err := kindsFromFlag(*kindFlag)
+		if err != nil {
+			return err
+		}
+
+		for _, num := range nums {
+			for _, kind := range kinds {
+				toPurge = append(toPurge, input.CacheEntry{Puzzle: num, Kind: kind})
+			}
+		}
+	}
+
+	for _, e := range toPurge {
+		if err := cache.Purge(e.Puzzle, e.Kind); err != nil {
+			return err
+		}
+		fmt.Printf("purged puzzle %d (%s)\n", e.Puzzle, e.Kind)
+	}
+
+	return nil
+}
+
+func cacheExportCommand(_ context.Context, args []string) error {
+	fs := newFlagSet("cache export")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("usage: i18n cache export <out.tar.gz>")
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	// write next to the destination and rename at the end, so a failed export doesn't leave half an archive behind
+	out := positional[0]
+	f, err := os.CreateTemp(filepath.Dir(out), "."+filepath.Base(out)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("could not create archive: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	names, skipped, err := c.ExportCache(f)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("could not write archive: %w", closeErr)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.Rename(f.Name(), out); err != nil {
+		return fmt.Errorf("could not write archive: %w", err)
+	}
+
+	for _, e := range skipped {
+		fmt.Printf("skipped puzzle %d (%s): %s\n", e.Puzzle, e.Kind, e.Problem)
+	}
+	fmt.Printf("exported %d files to %s\n", len(names), out)
+
+	return nil
+}
+
+func cacheImportCommand(_ context.Context, args []string) error {
+	fs := newFlagSet("cache import")
+	overwrite := fs.Bool("overwrite", false, "replace anything in the cache that disagrees with the archive instead of giving up")
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("usage: i18n cache import [-overwrite] <in.tar.gz>")
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(positional[0])
+	if err != nil {
+		return fmt.Errorf("could not open archive: %w", err)
+	}
+	defer f.Close()
+
+	res, err := c.ImportCache(f, *overwrite)
+	var ce *input.ConflictError
+	if errors.As(err, &ce) {
+		for _, curr := range ce.Conflicts {
+			fmt.Printf("conflict: %s\n", curr)
+		}
+		return fmt.Errorf("%d conflicts, nothing was imported (use -overwrite to replace what's in the cache)", len(ce.Conflicts))
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, curr := range res.Imported {
+		fmt.Printf("imported %s\n", curr)
+	}
+	fmt.Printf("imported %d files, %d already up to date\n", len(res.Imported), len(res.Unchanged))
+
+	return nil
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+func runCommand(ctx context.Context, args []string) error {
+	fs := newFlagSet("run")
+	test := fs.Bool("test", false, "use the test input instead of the real input")
+	example := fs.Bool("example", false, "solve the examples from the puzzle statement and check them against the published answers")
+	format := fs.String("format", "text", "how to print results: text, or json for the answers along with diagnostics and timings")
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *test && *example {
+		return fmt.Errorf("give either -test or -example, not both")
+	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("unknown format %q, expected text or json", *format)
+	}
+	if *example && *format == "json" {
+		return fmt.Errorf("-example only has text output")
+	}
+
+	puzzles, err := selectPuzzles(positional)
+	if err != nil {
+		return err
+	}
+
+	kind := kindFromFlag(*test)
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	ctx = solver.WithLogger(ctx, logger())
+
+	failed := 0
+	results := []solver.Result{}
+	for _, p := range puzzles {
+		if len(puzzles) > 1 && *format == "text" {
+			fmt.Printf("--- puzzle %d (%s) ---\n", p.Number, p.Name)
+		}
+
+		if *example {
+			if !runExamples(ctx, c, p) {
+				failed++
+			}
+			continue
+		}
+
+		res := p.Solve(ctx, c, kind)
+		if res.Err != nil {
+			failed++
+		}
+
+		if *format == "json" {
+			// the error is in the output, but advice on fixing it is still worth giving
+			results = append(results, res)
+			showHint(res.Err)
+			continue
+		}
+
+		if res.Err != nil {
+			printFailure(fmt.Sprintf("puzzle %d failed", p.Number), res.Err)
+			continue
+		}
+		fmt.Printf("%s\n", res.Answer)
+	}
+
+	if *format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+	}
+
+	return failures(failed, len(puzzles))
+}
+
+// runExamples checks a puzzle against the examples in its statement, returning whether they all passed
+func runExamples(ctx context.Context, c *input.Client, p solver.Puzzle) bool {
+	info, err := c.GetPuzzle(ctx, p.Number)
+	if err != nil {
+		printFailure(fmt.Sprintf("puzzle %d failed", p.Number), err)
+		return false
+	}
+
+	if len(info.Examples) == 0 {
+		printFailure(fmt.Sprintf("puzzle %d failed", p.Number), fmt.Errorf("no examples found on the page for %q", info.Title))
+		return false
+	}
+
+	ok := true
+	for i, ex := range info.Examples {
+		res := p.VerifyExample(ctx, ex)
+		switch res.Status {
+		case solver.VerifyPass:
+			fmt.Printf("%-7s example %d: %s\n", res.Status, i+1, res.Got)
+		case solver.VerifyFail:
+			fmt.Printf("%-7s example %d: expected %q, got %q\n", res.Status, i+1, res.Expected, res.Got)
+		default:
+			fmt.Printf("%-7s example %d: %s\n", res.Status, i+1, res.Err)
+		}
+
+		if res.Status.Regression() {
+			ok = false
+		}
+	}
+
+	return ok
+}
+
+func fetchCommand(ctx context.Context, args []string) error {
+	fs := newFlagSet("fetch")
+	kindFlag := fs.String("kind", "both", "which inputs to fetch: real, test or both")
+	workers := fs.Int("workers", input.DefaultPrefetchWorkers, "how many inputs to download at once")
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	nums, err := parseSelection(positional)
+	if err != nil {
+		return err
+	}
+
+	kinds, err := kindsFromFlag(*kindFlag)
+	if err != nil {
+		return err
+	}
+
+	c, err := newClient(input.WithPrefetchWorkers(*workers))
+	if err != nil {
+		return err
+	}
+
+	err = c.Prefetch(ctx, nums, kinds)
+	if err == nil {
+		fmt.Printf("fetched %d inputs\n", len(nums)*len(kinds))
+		return nil
+	}
+
+	// each failure was reported as it happened, so this is just for any advice
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+	for _, curr := range errs {
+		showHint(curr)
+	}
+
+	return failures(len(errs), len(nums)*len(kinds))
+}
+
+func verifyCommand(ctx context.Context, args []string) error {
+	fs := newFlagSet("verify")
+	kindFlag := fs.String("kind", "both", "which inputs to verify against: real, test or both")
+	record := fs.Bool("record", false, "save the answer as the expected answer for puzzles that don't have one yet")
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		positional = []string{"all"}
+	}
+
+	puzzles, err := selectPuzzles(positional)
+	if err != nil {
+		return err
+	}
+
+	kinds, err := kindsFromFlag(*kindFlag)
+	if err != nil {
+		return err
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	ctx = solver.WithLogger(ctx, logger())
+
+	counts := map[solver.VerifyStatus]int{}
+	regressions := 0
+	for _, p := range puzzles {
+		for _, kind := range kinds {
+			res := p.Verify(ctx, c, kind)
+			counts[res.Status]++
+
+			switch res.Status {
+			case solver.VerifyPass:
+				fmt.Printf("%-7s puzzle %2d (%s, %s)\n", res.Status, p.Number, p.Name, kind)
+			case solver.VerifyFail:
+				fmt.Printf("%-7s puzzle %2d (%s, %s): expected %q, got %q\n", res.Status, p.Number, p.Name, kind, res.Expected, res.Got)
+			case solver.VerifyMissing:
+				fmt.Printf("%-7s puzzle %2d (%s, %s): got %q\n", res.Status, p.Number, p.Name, kind, res.Got)
+				if *record {
+					if err := c.SetExpectedAnswer(p.Number, kind, res.Got.String()); err != nil {
+						return err
+					}
+					fmt.Printf("        recorded %q as the expected answer\n", res.Got)
+				}
+			case solver.VerifyError:
+				fmt.Printf("%-7s puzzle %2d (%s, %s): %s\n", res.Status, p.Number, p.Name, kind, res.Err)
+				showHint(res.Err)
+			}
+
+			if res.Status.Regression() {
+				regressions++
+			}
+		}
+	}
+
+	fmt.Printf("\n%d passed, %d failed, %d errors, %d missing\n", counts[solver.VerifyPass], counts[solver.VerifyFail], counts[solver.VerifyError], counts[solver.VerifyMissing])
+
+	if regressions > 0 {
+		return fmt.Errorf("%d regressions", regressions)
+	}
+
+	return nil
+}
+
+// kindsFromFlag turns the value of a -kind flag (real, test or both) in to the input kinds it refers to
+func kindsFromFlag(x string) ([]input.Kind, error) {
+	if x == "both" {
+		return []input.Kind{input.RealInput, input.TestInput}, nil
+	}
+
+	kind, err := input.ParseKind(x)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input kind: %s", x)
+	}
+
+	return []input.Kind{kind}, nil
+}
+
+// failures summarizes how many of the things we tried didn't work. The individual errors have already been printed
+// by the time this is called
+func failures(failed int, total int) error {
+	if failed == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d of %d failed", failed, total)
+}
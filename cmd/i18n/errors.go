@@ -0,0 +1,51 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lthummus/i18n-puzzles/input"
+)
+
+// hintsShown keeps us from giving the same advice once per puzzle when something like an expired token breaks all of
+// them
+var hintsShown = map[string]bool{}
+
+// hint is advice on how to fix err, or "" if we don't have any
+func hint(err error) string {
+	tokenFile := filepath.Join("~", input.DirectoryName, input.TokenFileName)
+
+	switch {
+	case errors.Is(err, input.ErrNoToken):
+		return fmt.Sprintf("no session token found: log in to %s, then copy the value of the sessionid cookie in to %s (or set $%s)", input.BaseURL, tokenFile, input.TokenEnvVar)
+	case errors.Is(err, input.ErrUnauthorized):
+		return fmt.Sprintf("your session token was rejected, it has probably expired: log in to %s again, then copy the new value of the sessionid cookie in to %s (or set $%s)", input.BaseURL, tokenFile, input.TokenEnvVar)
+	case errors.Is(err, input.ErrPuzzleLocked):
+		return "that puzzle hasn't been released yet, try again once it's out"
+	case errors.Is(err, input.ErrNotCached):
+		return fmt.Sprintf("offline mode is on, so only cached inputs can be used: unset $%s (or run \"i18n fetch\" somewhere with network access) to download the rest", input.OfflineEnvVar)
+	case errors.Is(err, input.ErrRateLimited):
+		return "the site is asking us to slow down, wait a bit before trying again"
+	default:
+		return ""
+	}
+}
+
+// showHint prints advice about err, unless it has already been given
+func showHint(err error) {
+	h := hint(err)
+	if h == "" || hintsShown[h] {
+		return
+	}
+	hintsShown[h] = true
+
+	fmt.Fprintf(os.Stderr, "hint: %s\n", h)
+}
+
+// printFailure reports that something (described by what) failed, along with any advice about fixing it
+func printFailure(what string, err error) {
+	fmt.Fprintf(os.Stderr, "%s: %s\n", what, err)
+	showHint(err)
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lthummus/i18n-puzzles/input"
+)
+
+func Test_hint(t *testing.T) {
+	assert.Contains(t, hint(fmt.Errorf("fetching: %w", input.ErrUnauthorized)), ".token")
+	assert.Contains(t, hint(input.ErrNoToken), input.TokenEnvVar)
+	assert.NotEmpty(t, hint(&input.HTTPError{StatusCode: 404, Err: input.ErrPuzzleLocked}))
+	assert.Contains(t, hint(fmt.Errorf("fetching: %w", input.ErrNotCached)), input.OfflineEnvVar)
+	assert.Empty(t, hint(fmt.Errorf("something else")))
+}
@@ -0,0 +1,112 @@
+// Command i18n runs, benchmarks and fetches inputs for any of the puzzles in this repo
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/all"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const usage = `usage: i18n <command> [flags] <puzzles>
+
+commands:
+  run     solve puzzles and print their answers
+  fetch   download puzzle inputs into the local cache
+  bench   time puzzle solvers
+  verify  check solvers still give their known good answers (defaults to all)
+  cache   list, verify, purge, export or import the local input cache
+
+<puzzles> can be a single number (12), a range (1-20), a comma separated list of
+those (1,3,5-7) or "all"
+
+every command takes -verbose to log what the downloader and solvers are doing. run
+"i18n <command> -h" for the other flags each command takes
+`
+
+type command func(ctx context.Context, args []string) error
+
+var commands = map[string]command{
+	"run":    runCommand,
+	"fetch":  fetchCommand,
+	"bench":  benchCommand,
+	"verify": verifyCommand,
+	"cache":  cacheCommand,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := cmd(ctx, os.Args[2:])
+	if err == flag.ErrHelp {
+		os.Exit(2)
+	}
+	if err != nil {
+		printFailure(fmt.Sprintf("i18n %s", os.Args[1]), err)
+		os.Exit(1)
+	}
+}
+
+// verbose is set by the -verbose flag every command takes
+var verbose bool
+
+// newFlagSet makes the flag set for a command, with the flags every command shares already on it
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.BoolVar(&verbose, "verbose", false, "log what the downloader and solvers are doing")
+	return fs
+}
+
+// logger is where diagnostics go: downloads and cache problems normally, plus solver traces with -verbose
+func logger() *slog.Logger {
+	return solver.NewConsoleLogger(os.Stderr, verbose)
+}
+
+// newClient makes an input client that logs to logger
+func newClient(opts ...input.Option) (*input.Client, error) {
+	return input.NewClient(append([]input.Option{input.WithLogger(logger())}, opts...)...)
+}
+
+// parseArgs parses flags that may come before or after the positional arguments (so both "run --test 12" and
+// "run 12 --test" work), returning the positional arguments
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func kindFromFlag(test bool) input.Kind {
+	if test {
+		return input.TestInput
+	}
+	return input.RealInput
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+// parseSelection turns something like "1,3,5-7" into the list of puzzle numbers it refers to. "all" selects every
+// registered puzzle
+func parseSelection(args []string) ([]int, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no puzzles given")
+	}
+
+	var ret []int
+	for _, arg := range args {
+		for _, part := range strings.Split(arg, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+
+			if part == "all" {
+				for _, curr := range solver.All() {
+					ret = append(ret, curr.Number)
+				}
+				continue
+			}
+
+			if lo, hi, isRange := strings.Cut(part, "-"); isRange {
+				start, err := strconv.Atoi(lo)
+				if err != nil {
+					return nil, fmt.Errorf("invalid puzzle range: %s", part)
+				}
+				end, err := strconv.Atoi(hi)
+				if err != nil {
+					return nil, fmt.Errorf("invalid puzzle range: %s", part)
+				}
+				if start < 1 || end < start {
+					return nil, fmt.Errorf("invalid puzzle range: %s", part)
+				}
+				for i := start; i <= end; i++ {
+					ret = append(ret, i)
+				}
+				continue
+			}
+
+			num, err := strconv.Atoi(part)
+			if err != nil || num < 1 {
+				return nil, fmt.Errorf("invalid puzzle number: %s", part)
+			}
+			ret = append(ret, num)
+		}
+	}
+
+	slices.Sort(ret)
+	return slices.Compact(ret), nil
+}
+
+// selectPuzzles is parseSelection, but every puzzle selected must have a solver registered
+func selectPuzzles(args []string) ([]solver.Puzzle, error) {
+	nums, err := parseSelection(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]solver.Puzzle, len(nums))
+	for i, num := range nums {
+		p, ok := solver.Lookup(num)
+		if !ok {
+			return nil, fmt.Errorf("no solver registered for puzzle %d", num)
+		}
+		ret[i] = p
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseSelection(t *testing.T) {
+	t.Run("happy cases", func(t *testing.T) {
+		tests := map[string][]int{
+			"12":      {12},
+			"1-4":     {1, 2, 3, 4},
+			"1,3,5-7": {1, 3, 5, 6, 7},
+			"3,1,3":   {1, 3},
+		}
+
+		for arg, expected := range tests {
+			nums, err := parseSelection([]string{arg})
+			assert.NoError(t, err)
+			assert.Equal(t, expected, nums, arg)
+		}
+	})
+
+	t.Run("all", func(t *testing.T) {
+		nums, err := parseSelection([]string{"all"})
+		assert.NoError(t, err)
+		assert.Len(t, nums, 20)
+		assert.Equal(t, 1, nums[0])
+		assert.Equal(t, 20, nums[len(nums)-1])
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, arg := range []string{"x", "5-", "7-3", "0", "-2"} {
+			_, err := parseSelection([]string{arg})
+			assert.Error(t, err, arg)
+		}
+
+		_, err := parseSelection(nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,107 @@
+// Command mockserver serves puzzle inputs, puzzle pages and answer submission from a fixtures directory, the same way
+// the real site does, so the downloader and the i18n command can be tried out without touching the real thing. Point
+// them at it with $I18N_PUZZLES_BASE_URL and use the token it prints as $I18N_PUZZLES_TOKEN
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lthummus/i18n-puzzles/input/inputtest"
+)
+
+const usage = `usage: mockserver [flags] <fixtures directory>
+
+The fixtures directory is laid out like the input cache: NN.txt and NN-test.txt
+for inputs, NN.puzzle.html for puzzle pages and answers.json for the answers
+submissions are checked against.
+
+flags:
+`
+
+// statusRecorder remembers the status of a response so it can be logged
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (sr *statusRecorder) WriteHeader(status int) {
+	sr.status = status
+	sr.ResponseWriter.WriteHeader(status)
+}
+
+func logRequests(logger *slog.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sr := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sr, r)
+		logger.Info("request", "method", r.Method, "path", r.URL.Path, "status", sr.status, "took", time.Since(start))
+	})
+}
+
+func main() {
+	fs := flag.NewFlagSet("mockserver", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		fs.PrintDefaults()
+	}
+
+	addr := fs.String("addr", "localhost:8018", "address to listen on")
+	token := fs.String("token", "mock-token", "session token to accept (empty accepts anything)")
+	delay := fs.Duration("delay", 0, "how long to wait before answering each request")
+	locked := fs.String("locked", "", "comma separated puzzle numbers to treat as not released yet")
+
+	var faults []inputtest.Fault
+	fs.Func("fault", "make requests fail, as PATTERN=STATUS[*TIMES] (like /puzzle/*/input=503*2). Can be given more than once", func(x string) error {
+		f, err := inputtest.ParseFault(x)
+		if err != nil {
+			return err
+		}
+		faults = append(faults, f)
+		return nil
+	})
+
+	fs.Parse(os.Args[1:])
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	site := inputtest.NewSite(*token)
+	if err := site.LoadDir(fs.Arg(0)); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+
+	if *locked != "" {
+		for _, curr := range strings.Split(*locked, ",") {
+			num, err := strconv.Atoi(strings.TrimSpace(curr))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "invalid puzzle number in -locked: %q\n", curr)
+				os.Exit(2)
+			}
+			site.Lock(num)
+		}
+	}
+
+	if *delay > 0 {
+		site.Inject(inputtest.Fault{Delay: *delay})
+	}
+	for _, f := range faults {
+		site.Inject(f)
+	}
+
+	logger.Info("listening", "url", "http://"+*addr, "token", *token, "fixtures", fs.Arg(0))
+	if err := http.ListenAndServe(*addr, logRequests(logger, site)); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+}
@@ -5,7 +5,9 @@ go 1.24
 require (
 	github.com/stretchr/testify v1.10.0
 	golang.org/x/crypto v0.36.0
+	golang.org/x/net v0.38.0
 	golang.org/x/text v0.23.0
+	golang.org/x/time v0.11.0
 )
 
 require (
@@ -0,0 +1,237 @@
+//go:build ignore
+
+// gen reads the Unicode Character Database files in ucd and writes tables.go, the property table the segmenter uses.
+// The files are checked in exactly as Unicode publishes them, and only the properties needed for grapheme clusters are
+// picked out of them here. To move to a new version of Unicode, replace them with the ones from
+// https://www.unicode.org/Public/<version>/ucd/ (auxiliary/GraphemeBreakProperty.txt, emoji/emoji-data.txt and
+// DerivedCoreProperties.txt, plus auxiliary/GraphemeBreakTest.txt in testdata) and run go generate
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// these have to match the names of the Property constants in grapheme.go
+var breakProperties = map[string]string{
+	"CR":                 "CR",
+	"LF":                 "LF",
+	"Control":            "Control",
+	"Extend":             "Extend",
+	"ZWJ":                "ZWJ",
+	"Regional_Indicator": "RegionalIndicator",
+	"Prepend":            "Prepend",
+	"SpacingMark":        "SpacingMark",
+	"L":                  "L",
+	"V":                  "V",
+	"T":                  "T",
+	"LV":                 "LV",
+	"LVT":                "LVT",
+}
+
+// ...and these the conjunctBreak constants
+var conjunctBreaks = map[string]string{
+	"Linker":    "conjunctLinker",
+	"Consonant": "conjunctConsonant",
+	"Extend":    "conjunctExtend",
+}
+
+// versionPatterns find the Unicode version in the header of a UCD file. Most name it along with the file
+// ("# DerivedCoreProperties-17.0.0.txt"), but emoji-data.txt has a "# Version: 17.0" line instead
+var versionPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^# \S+-(\d+\.\d+\.\d+)\.txt`),
+	regexp.MustCompile(`^# Version: (\d+\.\d+)\s*$`),
+}
+
+type value struct {
+	property string
+	conjunct string
+}
+
+func main() {
+	values := make([]value, unicode.MaxRune+1)
+	for i := range values {
+		values[i] = value{property: "Other", conjunct: "conjunctNone"}
+	}
+
+	version, err := parse("ucd/GraphemeBreakProperty.txt", func(fields []string, lo, hi rune) error {
+		prop, ok := breakProperties[fields[1]]
+		if !ok {
+			return fmt.Errorf("unknown Grapheme_Cluster_Break %q", fields[1])
+		}
+		for r := lo; r <= hi; r++ {
+			values[r].property = prop
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if version == "" {
+		log.Fatal("ucd/GraphemeBreakProperty.txt: no version in the header")
+	}
+
+	emojiVersion, err := parse("ucd/emoji-data.txt", func(fields []string, lo, hi rune) error {
+		if fields[1] != "Extended_Pictographic" {
+			return nil
+		}
+		for r := lo; r <= hi; r++ {
+			if values[r].property != "Other" {
+				return fmt.Errorf("%U is Extended_Pictographic and %s", r, values[r].property)
+			}
+			values[r].property = "ExtendedPictographic"
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	checkVersion("ucd/emoji-data.txt", emojiVersion, version)
+
+	coreVersion, err := parse("ucd/DerivedCoreProperties.txt", func(fields []string, lo, hi rune) error {
+		if fields[1] != "InCB" {
+			return nil
+		}
+		if len(fields) != 3 {
+			return fmt.Errorf("InCB without a value")
+		}
+		conjunct, ok := conjunctBreaks[fields[2]]
+		if !ok {
+			return fmt.Errorf("unknown Indic_Conjunct_Break %q", fields[2])
+		}
+		for r := lo; r <= hi; r++ {
+			values[r].conjunct = conjunct
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	checkVersion("ucd/DerivedCoreProperties.txt", coreVersion, version)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from the files in ucd; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package grapheme\n\n")
+	fmt.Fprintf(&buf, "// UnicodeVersion is the version of Unicode the property tables come from\n")
+	fmt.Fprintf(&buf, "const UnicodeVersion = %q\n\n", version)
+	fmt.Fprintf(&buf, "// propertyRanges covers every code point that isn't Other with no Indic_Conjunct_Break, in order\n")
+	fmt.Fprintf(&buf, "var propertyRanges = []propertyRange{\n")
+
+	ranges := 0
+	for lo := rune(0); lo <= unicode.MaxRune; {
+		hi := lo
+		for hi < unicode.MaxRune && values[hi+1] == values[lo] {
+			hi++
+		}
+		if v := values[lo]; v.property != "Other" || v.conjunct != "conjunctNone" {
+			fmt.Fprintf(&buf, "\t{0x%04X, 0x%04X, %s, %s},\n", lo, hi, v.property, v.conjunct)
+			ranges++
+		}
+		lo = hi + 1
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("tables.go", src, 0664); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("wrote %d ranges from Unicode %s", ranges, version)
+}
+
+// checkVersion makes sure a file is from the same version of Unicode as GraphemeBreakProperty.txt, so the tables
+// aren't built from a mix of versions. have can leave off the update number ("17.0" for 17.0.0)
+func checkVersion(name string, have string, want string) {
+	if have != want && !strings.HasPrefix(want, have+".") {
+		log.Fatalf("%s: from Unicode %q, but GraphemeBreakProperty.txt is from %s", name, have, want)
+	}
+}
+
+// parse reads a UCD data file, calling f with the fields of every line and the code points it covers. It returns the
+// Unicode version from the file's header, or "" if it doesn't have one
+func parse(name string, f func(fields []string, lo, hi rune) error) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	version := ""
+	lineNumber := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+
+		if version == "" && strings.HasPrefix(line, "#") {
+			for _, p := range versionPatterns {
+				if m := p.FindStringSubmatch(line); m != nil {
+					version = m[1]
+				}
+			}
+		}
+
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if len(fields) < 2 {
+			return "", fmt.Errorf("%s:%d: expected at least two fields", name, lineNumber)
+		}
+
+		lo, hi, err := parseCodePoints(fields[0])
+		if err != nil {
+			return "", fmt.Errorf("%s:%d: %w", name, lineNumber, err)
+		}
+
+		if err := f(fields, lo, hi); err != nil {
+			return "", fmt.Errorf("%s:%d: %w", name, lineNumber, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// parseCodePoints reads a code point (0915) or range of them (0915..0939)
+func parseCodePoints(x string) (rune, rune, error) {
+	loStr, hiStr, isRange := strings.Cut(x, "..")
+	if !isRange {
+		hiStr = loStr
+	}
+
+	lo, err := strconv.ParseUint(loStr, 16, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid code point %q", loStr)
+	}
+	hi, err := strconv.ParseUint(hiStr, 16, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid code point %q", hiStr)
+	}
+	if lo > hi || hi > unicode.MaxRune {
+		return 0, 0, fmt.Errorf("invalid range %q", x)
+	}
+
+	return rune(lo), rune(hi), nil
+}
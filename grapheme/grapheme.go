@@ -0,0 +1,302 @@
+// Package grapheme splits text in to extended grapheme clusters, following the rules in Unicode Standard Annex #29
+// (https://www.unicode.org/reports/tr29/). A grapheme cluster is what a person would call a character: a letter with
+// all of its accents, a Hangul syllable made of separate jamo, a flag, or an emoji made of several others joined
+// together.
+//
+// The property tables are generated from the Unicode Character Database files in ucd by gen.go, and the package is
+// tested against Unicode's own GraphemeBreakTest.txt.
+package grapheme
+
+//go:generate go run gen.go
+
+import (
+	"iter"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Property is a character's Grapheme_Cluster_Break property, except that Extended_Pictographic characters (which are
+// all Other) get a value of their own, since the emoji rules need it
+type Property uint8
+
+const (
+	Other Property = iota
+	CR
+	LF
+	Control
+	Extend
+	ZWJ
+	RegionalIndicator
+	Prepend
+	SpacingMark
+	L
+	V
+	T
+	LV
+	LVT
+	ExtendedPictographic
+)
+
+func (p Property) String() string {
+	switch p {
+	case Other:
+		return "Other"
+	case CR:
+		return "CR"
+	case LF:
+		return "LF"
+	case Control:
+		return "Control"
+	case Extend:
+		return "Extend"
+	case ZWJ:
+		return "ZWJ"
+	case RegionalIndicator:
+		return "Regional_Indicator"
+	case Prepend:
+		return "Prepend"
+	case SpacingMark:
+		return "SpacingMark"
+	case L:
+		return "L"
+	case V:
+		return "V"
+	case T:
+		return "T"
+	case LV:
+		return "LV"
+	case LVT:
+		return "LVT"
+	case ExtendedPictographic:
+		return "Extended_Pictographic"
+	default:
+		return "Property(" + strconv.Itoa(int(p)) + ")"
+	}
+}
+
+// conjunctBreak is a character's Indic_Conjunct_Break property, which keeps conjuncts in scripts like Devanagari
+// together
+type conjunctBreak uint8
+
+const (
+	conjunctNone conjunctBreak = iota
+	conjunctLinker
+	conjunctConsonant
+	conjunctExtend
+)
+
+type propertyRange struct {
+	lo, hi   rune
+	property Property
+	conjunct conjunctBreak
+}
+
+func lookup(r rune) (Property, conjunctBreak) {
+	i := sort.Search(len(propertyRanges), func(i int) bool {
+		return propertyRanges[i].hi >= r
+	})
+	if i < len(propertyRanges) && propertyRanges[i].lo <= r {
+		return propertyRanges[i].property, propertyRanges[i].conjunct
+	}
+
+	return Other, conjunctNone
+}
+
+// PropertyOf looks up a character's break property
+func PropertyOf(r rune) Property {
+	p, _ := lookup(r)
+	return p
+}
+
+// emojiState tracks GB11, which joins emoji sequences: Extended_Pictographic Extend* ZWJ × Extended_Pictographic
+type emojiState uint8
+
+const (
+	emojiNone emojiState = iota
+	emojiStarted
+	emojiJoined
+)
+
+// conjunctState tracks GB9c, which joins Indic conjuncts: a consonant, then any number of linkers and extenders
+// (at least one of them a linker) × another consonant
+type conjunctState uint8
+
+const (
+	conjunctOutside conjunctState = iota
+	conjunctAfterConsonant
+	conjunctLinked
+)
+
+// breaker applies the rules one character at a time, remembering what it needs to about the characters before
+type breaker struct {
+	started  bool
+	prev     Property
+	riCount  int
+	emoji    emojiState
+	conjunct conjunctState
+}
+
+// next reports whether there's a boundary before r, and moves past it
+func (b *breaker) next(r rune) bool {
+	p, c := lookup(r)
+	brk := !b.started || b.boundary(p, c)
+
+	switch {
+	case p == ExtendedPictographic:
+		b.emoji = emojiStarted
+	case p == Extend && b.emoji == emojiStarted:
+	case p == ZWJ && b.emoji == emojiStarted:
+		b.emoji = emojiJoined
+	default:
+		b.emoji = emojiNone
+	}
+
+	switch {
+	case c == conjunctConsonant:
+		b.conjunct = conjunctAfterConsonant
+	case c == conjunctLinker && b.conjunct != conjunctOutside:
+		b.conjunct = conjunctLinked
+	case c == conjunctExtend && b.conjunct != conjunctOutside:
+	default:
+		b.conjunct = conjunctOutside
+	}
+
+	if p == RegionalIndicator {
+		b.riCount++
+	} else {
+		b.riCount = 0
+	}
+
+	b.started = true
+	b.prev = p
+
+	return brk
+}
+
+func (b *breaker) boundary(p Property, c conjunctBreak) bool {
+	prev := b.prev
+
+	switch {
+	case prev == CR && p == LF: // GB3
+		return false
+	case prev == CR || prev == LF || prev == Control: // GB4
+		return true
+	case p == CR || p == LF || p == Control: // GB5
+		return true
+	case prev == L && (p == L || p == V || p == LV || p == LVT): // GB6
+		return false
+	case (prev == LV || prev == V) && (p == V || p == T): // GB7
+		return false
+	case (prev == LVT || prev == T) && p == T: // GB8
+		return false
+	case p == Extend || p == ZWJ: // GB9
+		return false
+	case p == SpacingMark: // GB9a
+		return false
+	case prev == Prepend: // GB9b
+		return false
+	case b.conjunct == conjunctLinked && c == conjunctConsonant: // GB9c
+		return false
+	case b.emoji == emojiJoined && p == ExtendedPictographic: // GB11
+		return false
+	case prev == RegionalIndicator && p == RegionalIndicator: // GB12, GB13
+		return b.riCount%2 == 0
+	}
+
+	return true // GB999
+}
+
+// Iterator steps through the grapheme clusters in some text
+//
+//	it := grapheme.NewIterator(s)
+//	for it.Next() {
+//		fmt.Println(it.Cluster())
+//	}
+type Iterator struct {
+	text       string
+	start, end int
+
+	b breaker
+
+	// pending is the width of the character at end, which has been looked at to find the end of the last cluster but
+	// not moved past
+	pending int
+}
+
+// NewIterator starts iterating over text
+func NewIterator(text string) *Iterator {
+	return &Iterator{text: text}
+}
+
+// Next moves to the next cluster, returning false when there aren't any more
+func (it *Iterator) Next() bool {
+	it.start = it.end
+	if it.start >= len(it.text) {
+		return false
+	}
+
+	it.end += it.pending
+	it.pending = 0
+	if it.end == it.start {
+		r, size := utf8.DecodeRuneInString(it.text[it.end:])
+		it.b.next(r)
+		it.end += size
+	}
+
+	for it.end < len(it.text) {
+		r, size := utf8.DecodeRuneInString(it.text[it.end:])
+		if it.b.next(r) {
+			it.pending = size
+			break
+		}
+		it.end += size
+	}
+
+	return true
+}
+
+// Cluster is the current cluster
+func (it *Iterator) Cluster() string {
+	return it.text[it.start:it.end]
+}
+
+// Position is where the current cluster is in the text, as byte offsets
+func (it *Iterator) Position() (start int, end int) {
+	return it.start, it.end
+}
+
+// All is an iterator over the grapheme clusters in text
+func All(text string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		it := NewIterator(text)
+		for it.Next() {
+			if !yield(it.Cluster()) {
+				return
+			}
+		}
+	}
+}
+
+// Split splits text in to grapheme clusters
+func Split(text string) []string {
+	var clusters []string
+	for c := range All(text) {
+		clusters = append(clusters, c)
+	}
+	return clusters
+}
+
+// Count counts the grapheme clusters in text
+func Count(text string) int {
+	count := 0
+
+	var b breaker
+	for _, r := range text {
+		if b.next(r) {
+			count++
+		}
+	}
+
+	return count
+}
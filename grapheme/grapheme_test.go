@@ -0,0 +1,116 @@
+package grapheme
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readBreakTests reads GraphemeBreakTest.txt, giving the text of each test along with the clusters it should be split
+// in to
+func readBreakTests(t *testing.T) (texts []string, want [][]string) {
+	f, err := os.Open("testdata/GraphemeBreakTest.txt")
+	require.NoError(t, err)
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var text, cluster strings.Builder
+		var clusters []string
+		for _, field := range strings.Fields(line) {
+			switch field {
+			case "÷":
+				if cluster.Len() > 0 {
+					clusters = append(clusters, cluster.String())
+					cluster.Reset()
+				}
+			case "×":
+			default:
+				cp, err := strconv.ParseUint(field, 16, 32)
+				require.NoError(t, err, line)
+				text.WriteRune(rune(cp))
+				cluster.WriteRune(rune(cp))
+			}
+		}
+
+		texts = append(texts, text.String())
+		want = append(want, clusters)
+	}
+	require.NoError(t, scanner.Err())
+
+	return texts, want
+}
+
+func Test_Conformance(t *testing.T) {
+	texts, want := readBreakTests(t)
+	require.Greater(t, len(texts), 500)
+
+	for i, text := range texts {
+		if !assert.Equal(t, want[i], Split(text), "%+q", text) {
+			continue
+		}
+		assert.Equal(t, len(want[i]), Count(text), "%+q", text)
+	}
+}
+
+func Test_Split(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "empty", text: "", want: nil},
+		{name: "combining marks", text: "éx", want: []string{"é", "x"}},
+		{name: "crlf", text: "a\r\nb", want: []string{"a", "\r\n", "b"}},
+		{name: "family", text: "👨‍👩‍👧‍👦!", want: []string{"👨‍👩‍👧‍👦", "!"}},
+		{name: "flags", text: "🇯🇵🇫🇷🇩", want: []string{"🇯🇵", "🇫🇷", "🇩"}},
+		{name: "hangul jamo", text: "각a", want: []string{"각", "a"}},
+		{name: "devanagari conjunct", text: "क्षि", want: []string{"क्षि"}},
+		{name: "invalid utf-8", text: "a\xffb", want: []string{"a", "\xff", "b"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Split(tc.text))
+			assert.Equal(t, len(tc.want), Count(tc.text))
+		})
+	}
+}
+
+func Test_Iterator(t *testing.T) {
+	text := "a👍🏽b"
+	it := NewIterator(text)
+
+	var positions [][2]int
+	for it.Next() {
+		start, end := it.Position()
+		assert.Equal(t, text[start:end], it.Cluster())
+		positions = append(positions, [2]int{start, end})
+	}
+	assert.Equal(t, [][2]int{{0, 1}, {1, 9}, {9, 10}}, positions)
+	assert.False(t, it.Next())
+
+	for c := range All(text) {
+		assert.Equal(t, "a", c)
+		break
+	}
+}
+
+func Test_PropertyOf(t *testing.T) {
+	assert.Equal(t, Other, PropertyOf('a'))
+	assert.Equal(t, Extend, PropertyOf(0x0301))
+	assert.Equal(t, ExtendedPictographic, PropertyOf('😀'))
+	assert.Equal(t, RegionalIndicator, PropertyOf('🇯'))
+	assert.Equal(t, LVT, PropertyOf('각'))
+	assert.Equal(t, "Regional_Indicator", RegionalIndicator.String())
+}
@@ -0,0 +1,1641 @@
+// Code generated by gen.go from the files in ucd; DO NOT EDIT.
+
+package grapheme
+
+// UnicodeVersion is the version of Unicode the property tables come from
+const UnicodeVersion = "17.0.0"
+
+// propertyRanges covers every code point that isn't Other with no Indic_Conjunct_Break, in order
+var propertyRanges = []propertyRange{
+	{0x0000, 0x0009, Control, conjunctNone},
+	{0x000A, 0x000A, LF, conjunctNone},
+	{0x000B, 0x000C, Control, conjunctNone},
+	{0x000D, 0x000D, CR, conjunctNone},
+	{0x000E, 0x001F, Control, conjunctNone},
+	{0x007F, 0x009F, Control, conjunctNone},
+	{0x00A9, 0x00A9, ExtendedPictographic, conjunctNone},
+	{0x00AD, 0x00AD, Control, conjunctNone},
+	{0x00AE, 0x00AE, ExtendedPictographic, conjunctNone},
+	{0x0300, 0x036F, Extend, conjunctExtend},
+	{0x0483, 0x0489, Extend, conjunctExtend},
+	{0x0591, 0x05BD, Extend, conjunctExtend},
+	{0x05BF, 0x05BF, Extend, conjunctExtend},
+	{0x05C1, 0x05C2, Extend, conjunctExtend},
+	{0x05C4, 0x05C5, Extend, conjunctExtend},
+	{0x05C7, 0x05C7, Extend, conjunctExtend},
+	{0x0600, 0x0605, Prepend, conjunctNone},
+	{0x0610, 0x061A, Extend, conjunctExtend},
+	{0x061C, 0x061C, Control, conjunctNone},
+	{0x064B, 0x065F, Extend, conjunctExtend},
+	{0x0670, 0x0670, Extend, conjunctExtend},
+	{0x06D6, 0x06DC, Extend, conjunctExtend},
+	{0x06DD, 0x06DD, Prepend, conjunctNone},
+	{0x06DF, 0x06E4, Extend, conjunctExtend},
+	{0x06E7, 0x06E8, Extend, conjunctExtend},
+	{0x06EA, 0x06ED, Extend, conjunctExtend},
+	{0x070F, 0x070F, Prepend, conjunctNone},
+	{0x0711, 0x0711, Extend, conjunctExtend},
+	{0x0730, 0x074A, Extend, conjunctExtend},
+	{0x07A6, 0x07B0, Extend, conjunctExtend},
+	{0x07EB, 0x07F3, Extend, conjunctExtend},
+	{0x07FD, 0x07FD, Extend, conjunctExtend},
+	{0x0816, 0x0819, Extend, conjunctExtend},
+	{0x081B, 0x0823, Extend, conjunctExtend},
+	{0x0825, 0x0827, Extend, conjunctExtend},
+	{0x0829, 0x082D, Extend, conjunctExtend},
+	{0x0859, 0x085B, Extend, conjunctExtend},
+	{0x0890, 0x0891, Prepend, conjunctNone},
+	{0x0897, 0x089F, Extend, conjunctExtend},
+	{0x08CA, 0x08E1, Extend, conjunctExtend},
+	{0x08E2, 0x08E2, Prepend, conjunctNone},
+	{0x08E3, 0x0902, Extend, conjunctExtend},
+	{0x0903, 0x0903, SpacingMark, conjunctNone},
+	{0x0915, 0x0939, Other, conjunctConsonant},
+	{0x093A, 0x093A, Extend, conjunctExtend},
+	{0x093B, 0x093B, SpacingMark, conjunctNone},
+	{0x093C, 0x093C, Extend, conjunctExtend},
+	{0x093E, 0x0940, SpacingMark, conjunctNone},
+	{0x0941, 0x0948, Extend, conjunctExtend},
+	{0x0949, 0x094C, SpacingMark, conjunctNone},
+	{0x094D, 0x094D, Extend, conjunctLinker},
+	{0x094E, 0x094F, SpacingMark, conjunctNone},
+	{0x0951, 0x0957, Extend, conjunctExtend},
+	{0x0958, 0x095F, Other, conjunctConsonant},
+	{0x0962, 0x0963, Extend, conjunctExtend},
+	{0x0978, 0x097F, Other, conjunctConsonant},
+	{0x0981, 0x0981, Extend, conjunctExtend},
+	{0x0982, 0x0983, SpacingMark, conjunctNone},
+	{0x0995, 0x09A8, Other, conjunctConsonant},
+	{0x09AA, 0x09B0, Other, conjunctConsonant},
+	{0x09B2, 0x09B2, Other, conjunctConsonant},
+	{0x09B6, 0x09B9, Other, conjunctConsonant},
+	{0x09BC, 0x09BC, Extend, conjunctExtend},
+	{0x09BE, 0x09BE, Extend, conjunctExtend},
+	{0x09BF, 0x09C0, SpacingMark, conjunctNone},
+	{0x09C1, 0x09C4, Extend, conjunctExtend},
+	{0x09C7, 0x09C8, SpacingMark, conjunctNone},
+	{0x09CB, 0x09CC, SpacingMark, conjunctNone},
+	{0x09CD, 0x09CD, Extend, conjunctLinker},
+	{0x09D7, 0x09D7, Extend, conjunctExtend},
+	{0x09DC, 0x09DD, Other, conjunctConsonant},
+	{0x09DF, 0x09DF, Other, conjunctConsonant},
+	{0x09E2, 0x09E3, Extend, conjunctExtend},
+	{0x09F0, 0x09F1, Other, conjunctConsonant},
+	{0x09FE, 0x09FE, Extend, conjunctExtend},
+	{0x0A01, 0x0A02, Extend, conjunctExtend},
+	{0x0A03, 0x0A03, SpacingMark, conjunctNone},
+	{0x0A3C, 0x0A3C, Extend, conjunctExtend},
+	{0x0A3E, 0x0A40, SpacingMark, conjunctNone},
+	{0x0A41, 0x0A42, Extend, conjunctExtend},
+	{0x0A47, 0x0A48, Extend, conjunctExtend},
+	{0x0A4B, 0x0A4D, Extend, conjunctExtend},
+	{0x0A51, 0x0A51, Extend, conjunctExtend},
+	{0x0A70, 0x0A71, Extend, conjunctExtend},
+	{0x0A75, 0x0A75, Extend, conjunctExtend},
+	{0x0A81, 0x0A82, Extend, conjunctExtend},
+	{0x0A83, 0x0A83, SpacingMark, conjunctNone},
+	{0x0A95, 0x0AA8, Other, conjunctConsonant},
+	{0x0AAA, 0x0AB0, Other, conjunctConsonant},
+	{0x0AB2, 0x0AB3, Other, conjunctConsonant},
+	{0x0AB5, 0x0AB9, Other, conjunctConsonant},
+	{0x0ABC, 0x0ABC, Extend, conjunctExtend},
+	{0x0ABE, 0x0AC0, SpacingMark, conjunctNone},
+	{0x0AC1, 0x0AC5, Extend, conjunctExtend},
+	{0x0AC7, 0x0AC8, Extend, conjunctExtend},
+	{0x0AC9, 0x0AC9, SpacingMark, conjunctNone},
+	{0x0ACB, 0x0ACC, SpacingMark, conjunctNone},
+	{0x0ACD, 0x0ACD, Extend, conjunctLinker},
+	{0x0AE2, 0x0AE3, Extend, conjunctExtend},
+	{0x0AF9, 0x0AF9, Other, conjunctConsonant},
+	{0x0AFA, 0x0AFF, Extend, conjunctExtend},
+	{0x0B01, 0x0B01, Extend, conjunctExtend},
+	{0x0B02, 0x0B03, SpacingMark, conjunctNone},
+	{0x0B15, 0x0B28, Other, conjunctConsonant},
+	{0x0B2A, 0x0B30, Other, conjunctConsonant},
+	{0x0B32, 0x0B33, Other, conjunctConsonant},
+	{0x0B35, 0x0B39, Other, conjunctConsonant},
+	{0x0B3C, 0x0B3C, Extend, conjunctExtend},
+	{0x0B3E, 0x0B3F, Extend, conjunctExtend},
+	{0x0B40, 0x0B40, SpacingMark, conjunctNone},
+	{0x0B41, 0x0B44, Extend, conjunctExtend},
+	{0x0B47, 0x0B48, SpacingMark, conjunctNone},
+	{0x0B4B, 0x0B4C, SpacingMark, conjunctNone},
+	{0x0B4D, 0x0B4D, Extend, conjunctLinker},
+	{0x0B55, 0x0B57, Extend, conjunctExtend},
+	{0x0B5C, 0x0B5D, Other, conjunctConsonant},
+	{0x0B5F, 0x0B5F, Other, conjunctConsonant},
+	{0x0B62, 0x0B63, Extend, conjunctExtend},
+	{0x0B71, 0x0B71, Other, conjunctConsonant},
+	{0x0B82, 0x0B82, Extend, conjunctExtend},
+	{0x0BBE, 0x0BBE, Extend, conjunctExtend},
+	{0x0BBF, 0x0BBF, SpacingMark, conjunctNone},
+	{0x0BC0, 0x0BC0, Extend, conjunctExtend},
+	{0x0BC1, 0x0BC2, SpacingMark, conjunctNone},
+	{0x0BC6, 0x0BC8, SpacingMark, conjunctNone},
+	{0x0BCA, 0x0BCC, SpacingMark, conjunctNone},
+	{0x0BCD, 0x0BCD, Extend, conjunctExtend},
+	{0x0BD7, 0x0BD7, Extend, conjunctExtend},
+	{0x0C00, 0x0C00, Extend, conjunctExtend},
+	{0x0C01, 0x0C03, SpacingMark, conjunctNone},
+	{0x0C04, 0x0C04, Extend, conjunctExtend},
+	{0x0C15, 0x0C28, Other, conjunctConsonant},
+	{0x0C2A, 0x0C39, Other, conjunctConsonant},
+	{0x0C3C, 0x0C3C, Extend, conjunctExtend},
+	{0x0C3E, 0x0C40, Extend, conjunctExtend},
+	{0x0C41, 0x0C44, SpacingMark, conjunctNone},
+	{0x0C46, 0x0C48, Extend, conjunctExtend},
+	{0x0C4A, 0x0C4C, Extend, conjunctExtend},
+	{0x0C4D, 0x0C4D, Extend, conjunctLinker},
+	{0x0C55, 0x0C56, Extend, conjunctExtend},
+	{0x0C58, 0x0C5A, Other, conjunctConsonant},
+	{0x0C62, 0x0C63, Extend, conjunctExtend},
+	{0x0C81, 0x0C81, Extend, conjunctExtend},
+	{0x0C82, 0x0C83, SpacingMark, conjunctNone},
+	{0x0CBC, 0x0CBC, Extend, conjunctExtend},
+	{0x0CBE, 0x0CBE, SpacingMark, conjunctNone},
+	{0x0CBF, 0x0CC0, Extend, conjunctExtend},
+	{0x0CC1, 0x0CC1, SpacingMark, conjunctNone},
+	{0x0CC2, 0x0CC2, Extend, conjunctExtend},
+	{0x0CC3, 0x0CC4, SpacingMark, conjunctNone},
+	{0x0CC6, 0x0CC8, Extend, conjunctExtend},
+	{0x0CCA, 0x0CCD, Extend, conjunctExtend},
+	{0x0CD5, 0x0CD6, Extend, conjunctExtend},
+	{0x0CE2, 0x0CE3, Extend, conjunctExtend},
+	{0x0CF3, 0x0CF3, SpacingMark, conjunctNone},
+	{0x0D00, 0x0D01, Extend, conjunctExtend},
+	{0x0D02, 0x0D03, SpacingMark, conjunctNone},
+	{0x0D15, 0x0D3A, Other, conjunctConsonant},
+	{0x0D3B, 0x0D3C, Extend, conjunctExtend},
+	{0x0D3E, 0x0D3E, Extend, conjunctExtend},
+	{0x0D3F, 0x0D40, SpacingMark, conjunctNone},
+	{0x0D41, 0x0D44, Extend, conjunctExtend},
+	{0x0D46, 0x0D48, SpacingMark, conjunctNone},
+	{0x0D4A, 0x0D4C, SpacingMark, conjunctNone},
+	{0x0D4D, 0x0D4D, Extend, conjunctLinker},
+	{0x0D4E, 0x0D4E, Prepend, conjunctNone},
+	{0x0D57, 0x0D57, Extend, conjunctExtend},
+	{0x0D62, 0x0D63, Extend, conjunctExtend},
+	{0x0D81, 0x0D81, Extend, conjunctExtend},
+	{0x0D82, 0x0D83, SpacingMark, conjunctNone},
+	{0x0DCA, 0x0DCA, Extend, conjunctExtend},
+	{0x0DCF, 0x0DCF, Extend, conjunctExtend},
+	{0x0DD0, 0x0DD1, SpacingMark, conjunctNone},
+	{0x0DD2, 0x0DD4, Extend, conjunctExtend},
+	{0x0DD6, 0x0DD6, Extend, conjunctExtend},
+	{0x0DD8, 0x0DDE, SpacingMark, conjunctNone},
+	{0x0DDF, 0x0DDF, Extend, conjunctExtend},
+	{0x0DF2, 0x0DF3, SpacingMark, conjunctNone},
+	{0x0E31, 0x0E31, Extend, conjunctExtend},
+	{0x0E33, 0x0E33, SpacingMark, conjunctNone},
+	{0x0E34, 0x0E3A, Extend, conjunctExtend},
+	{0x0E47, 0x0E4E, Extend, conjunctExtend},
+	{0x0EB1, 0x0EB1, Extend, conjunctExtend},
+	{0x0EB3, 0x0EB3, SpacingMark, conjunctNone},
+	{0x0EB4, 0x0EBC, Extend, conjunctExtend},
+	{0x0EC8, 0x0ECE, Extend, conjunctExtend},
+	{0x0F18, 0x0F19, Extend, conjunctExtend},
+	{0x0F35, 0x0F35, Extend, conjunctExtend},
+	{0x0F37, 0x0F37, Extend, conjunctExtend},
+	{0x0F39, 0x0F39, Extend, conjunctExtend},
+	{0x0F3E, 0x0F3F, SpacingMark, conjunctNone},
+	{0x0F71, 0x0F7E, Extend, conjunctExtend},
+	{0x0F7F, 0x0F7F, SpacingMark, conjunctNone},
+	{0x0F80, 0x0F84, Extend, conjunctExtend},
+	{0x0F86, 0x0F87, Extend, conjunctExtend},
+	{0x0F8D, 0x0F97, Extend, conjunctExtend},
+	{0x0F99, 0x0FBC, Extend, conjunctExtend},
+	{0x0FC6, 0x0FC6, Extend, conjunctExtend},
+	{0x1000, 0x102A, Other, conjunctConsonant},
+	{0x102D, 0x1030, Extend, conjunctExtend},
+	{0x1031, 0x1031, SpacingMark, conjunctNone},
+	{0x1032, 0x1037, Extend, conjunctExtend},
+	{0x1039, 0x1039, Extend, conjunctLinker},
+	{0x103A, 0x103A, Extend, conjunctExtend},
+	{0x103B, 0x103C, SpacingMark, conjunctNone},
+	{0x103D, 0x103E, Extend, conjunctExtend},
+	{0x103F, 0x103F, Other, conjunctConsonant},
+	{0x1050, 0x1055, Other, conjunctConsonant},
+	{0x1056, 0x1057, SpacingMark, conjunctNone},
+	{0x1058, 0x1059, Extend, conjunctExtend},
+	{0x105A, 0x105D, Other, conjunctConsonant},
+	{0x105E, 0x1060, Extend, conjunctExtend},
+	{0x1061, 0x1061, Other, conjunctConsonant},
+	{0x1065, 0x1066, Other, conjunctConsonant},
+	{0x106E, 0x1070, Other, conjunctConsonant},
+	{0x1071, 0x1074, Extend, conjunctExtend},
+	{0x1075, 0x1081, Other, conjunctConsonant},
+	{0x1082, 0x1082, Extend, conjunctExtend},
+	{0x1084, 0x1084, SpacingMark, conjunctNone},
+	{0x1085, 0x1086, Extend, conjunctExtend},
+	{0x108D, 0x108D, Extend, conjunctExtend},
+	{0x108E, 0x108E, Other, conjunctConsonant},
+	{0x109D, 0x109D, Extend, conjunctExtend},
+	{0x1100, 0x115F, L, conjunctNone},
+	{0x1160, 0x11A7, V, conjunctNone},
+	{0x11A8, 0x11FF, T, conjunctNone},
+	{0x135D, 0x135F, Extend, conjunctExtend},
+	{0x1712, 0x1715, Extend, conjunctExtend},
+	{0x1732, 0x1734, Extend, conjunctExtend},
+	{0x1752, 0x1753, Extend, conjunctExtend},
+	{0x1772, 0x1773, Extend, conjunctExtend},
+	{0x1780, 0x17B3, Other, conjunctConsonant},
+	{0x17B4, 0x17B5, Extend, conjunctExtend},
+	{0x17B6, 0x17B6, SpacingMark, conjunctNone},
+	{0x17B7, 0x17BD, Extend, conjunctExtend},
+	{0x17BE, 0x17C5, SpacingMark, conjunctNone},
+	{0x17C6, 0x17C6, Extend, conjunctExtend},
+	{0x17C7, 0x17C8, SpacingMark, conjunctNone},
+	{0x17C9, 0x17D1, Extend, conjunctExtend},
+	{0x17D2, 0x17D2, Extend, conjunctLinker},
+	{0x17D3, 0x17D3, Extend, conjunctExtend},
+	{0x17DD, 0x17DD, Extend, conjunctExtend},
+	{0x180B, 0x180D, Extend, conjunctExtend},
+	{0x180E, 0x180E, Control, conjunctNone},
+	{0x180F, 0x180F, Extend, conjunctExtend},
+	{0x1885, 0x1886, Extend, conjunctExtend},
+	{0x18A9, 0x18A9, Extend, conjunctExtend},
+	{0x1920, 0x1922, Extend, conjunctExtend},
+	{0x1923, 0x1926, SpacingMark, conjunctNone},
+	{0x1927, 0x1928, Extend, conjunctExtend},
+	{0x1929, 0x192B, SpacingMark, conjunctNone},
+	{0x1930, 0x1931, SpacingMark, conjunctNone},
+	{0x1932, 0x1932, Extend, conjunctExtend},
+	{0x1933, 0x1938, SpacingMark, conjunctNone},
+	{0x1939, 0x193B, Extend, conjunctExtend},
+	{0x1A17, 0x1A18, Extend, conjunctExtend},
+	{0x1A19, 0x1A1A, SpacingMark, conjunctNone},
+	{0x1A1B, 0x1A1B, Extend, conjunctExtend},
+	{0x1A20, 0x1A54, Other, conjunctConsonant},
+	{0x1A55, 0x1A55, SpacingMark, conjunctNone},
+	{0x1A56, 0x1A56, Extend, conjunctExtend},
+	{0x1A57, 0x1A57, SpacingMark, conjunctNone},
+	{0x1A58, 0x1A5E, Extend, conjunctExtend},
+	{0x1A60, 0x1A60, Extend, conjunctLinker},
+	{0x1A62, 0x1A62, Extend, conjunctExtend},
+	{0x1A65, 0x1A6C, Extend, conjunctExtend},
+	{0x1A6D, 0x1A72, SpacingMark, conjunctNone},
+	{0x1A73, 0x1A7C, Extend, conjunctExtend},
+	{0x1A7F, 0x1A7F, Extend, conjunctExtend},
+	{0x1AB0, 0x1ADD, Extend, conjunctExtend},
+	{0x1AE0, 0x1AEB, Extend, conjunctExtend},
+	{0x1B00, 0x1B03, Extend, conjunctExtend},
+	{0x1B04, 0x1B04, SpacingMark, conjunctNone},
+	{0x1B0B, 0x1B0C, Other, conjunctConsonant},
+	{0x1B13, 0x1B33, Other, conjunctConsonant},
+	{0x1B34, 0x1B3D, Extend, conjunctExtend},
+	{0x1B3E, 0x1B41, SpacingMark, conjunctNone},
+	{0x1B42, 0x1B43, Extend, conjunctExtend},
+	{0x1B44, 0x1B44, Extend, conjunctLinker},
+	{0x1B45, 0x1B4C, Other, conjunctConsonant},
+	{0x1B6B, 0x1B73, Extend, conjunctExtend},
+	{0x1B80, 0x1B81, Extend, conjunctExtend},
+	{0x1B82, 0x1B82, SpacingMark, conjunctNone},
+	{0x1B83, 0x1BA0, Other, conjunctConsonant},
+	{0x1BA1, 0x1BA1, SpacingMark, conjunctNone},
+	{0x1BA2, 0x1BA5, Extend, conjunctExtend},
+	{0x1BA6, 0x1BA7, SpacingMark, conjunctNone},
+	{0x1BA8, 0x1BAA, Extend, conjunctExtend},
+	{0x1BAB, 0x1BAB, Extend, conjunctLinker},
+	{0x1BAC, 0x1BAD, Extend, conjunctExtend},
+	{0x1BAE, 0x1BAF, Other, conjunctConsonant},
+	{0x1BBB, 0x1BBD, Other, conjunctConsonant},
+	{0x1BE6, 0x1BE6, Extend, conjunctExtend},
+	{0x1BE7, 0x1BE7, SpacingMark, conjunctNone},
+	{0x1BE8, 0x1BE9, Extend, conjunctExtend},
+	{0x1BEA, 0x1BEC, SpacingMark, conjunctNone},
+	{0x1BED, 0x1BED, Extend, conjunctExtend},
+	{0x1BEE, 0x1BEE, SpacingMark, conjunctNone},
+	{0x1BEF, 0x1BF3, Extend, conjunctExtend},
+	{0x1C24, 0x1C2B, SpacingMark, conjunctNone},
+	{0x1C2C, 0x1C33, Extend, conjunctExtend},
+	{0x1C34, 0x1C35, SpacingMark, conjunctNone},
+	{0x1C36, 0x1C37, Extend, conjunctExtend},
+	{0x1CD0, 0x1CD2, Extend, conjunctExtend},
+	{0x1CD4, 0x1CE0, Extend, conjunctExtend},
+	{0x1CE1, 0x1CE1, SpacingMark, conjunctNone},
+	{0x1CE2, 0x1CE8, Extend, conjunctExtend},
+	{0x1CED, 0x1CED, Extend, conjunctExtend},
+	{0x1CF4, 0x1CF4, Extend, conjunctExtend},
+	{0x1CF7, 0x1CF7, SpacingMark, conjunctNone},
+	{0x1CF8, 0x1CF9, Extend, conjunctExtend},
+	{0x1DC0, 0x1DFF, Extend, conjunctExtend},
+	{0x200B, 0x200B, Control, conjunctNone},
+	{0x200C, 0x200C, Extend, conjunctNone},
+	{0x200D, 0x200D, ZWJ, conjunctExtend},
+	{0x200E, 0x200F, Control, conjunctNone},
+	{0x2028, 0x202E, Control, conjunctNone},
+	{0x203C, 0x203C, ExtendedPictographic, conjunctNone},
+	{0x2049, 0x2049, ExtendedPictographic, conjunctNone},
+	{0x2060, 0x206F, Control, conjunctNone},
+	{0x20D0, 0x20F0, Extend, conjunctExtend},
+	{0x2122, 0x2122, ExtendedPictographic, conjunctNone},
+	{0x2139, 0x2139, ExtendedPictographic, conjunctNone},
+	{0x2194, 0x2199, ExtendedPictographic, conjunctNone},
+	{0x21A9, 0x21AA, ExtendedPictographic, conjunctNone},
+	{0x231A, 0x231B, ExtendedPictographic, conjunctNone},
+	{0x2328, 0x2328, ExtendedPictographic, conjunctNone},
+	{0x23CF, 0x23CF, ExtendedPictographic, conjunctNone},
+	{0x23E9, 0x23F3, ExtendedPictographic, conjunctNone},
+	{0x23F8, 0x23FA, ExtendedPictographic, conjunctNone},
+	{0x24C2, 0x24C2, ExtendedPictographic, conjunctNone},
+	{0x25AA, 0x25AB, ExtendedPictographic, conjunctNone},
+	{0x25B6, 0x25B6, ExtendedPictographic, conjunctNone},
+	{0x25C0, 0x25C0, ExtendedPictographic, conjunctNone},
+	{0x25FB, 0x25FE, ExtendedPictographic, conjunctNone},
+	{0x2600, 0x2604, ExtendedPictographic, conjunctNone},
+	{0x260E, 0x260E, ExtendedPictographic, conjunctNone},
+	{0x2611, 0x2611, ExtendedPictographic, conjunctNone},
+	{0x2614, 0x2615, ExtendedPictographic, conjunctNone},
+	{0x2618, 0x2618, ExtendedPictographic, conjunctNone},
+	{0x261D, 0x261D, ExtendedPictographic, conjunctNone},
+	{0x2620, 0x2620, ExtendedPictographic, conjunctNone},
+	{0x2622, 0x2623, ExtendedPictographic, conjunctNone},
+	{0x2626, 0x2626, ExtendedPictographic, conjunctNone},
+	{0x262A, 0x262A, ExtendedPictographic, conjunctNone},
+	{0x262E, 0x262F, ExtendedPictographic, conjunctNone},
+	{0x2638, 0x263A, ExtendedPictographic, conjunctNone},
+	{0x2640, 0x2640, ExtendedPictographic, conjunctNone},
+	{0x2642, 0x2642, ExtendedPictographic, conjunctNone},
+	{0x2648, 0x2653, ExtendedPictographic, conjunctNone},
+	{0x265F, 0x2660, ExtendedPictographic, conjunctNone},
+	{0x2663, 0x2663, ExtendedPictographic, conjunctNone},
+	{0x2665, 0x2666, ExtendedPictographic, conjunctNone},
+	{0x2668, 0x2668, ExtendedPictographic, conjunctNone},
+	{0x267B, 0x267B, ExtendedPictographic, conjunctNone},
+	{0x267E, 0x267F, ExtendedPictographic, conjunctNone},
+	{0x2692, 0x2697, ExtendedPictographic, conjunctNone},
+	{0x2699, 0x2699, ExtendedPictographic, conjunctNone},
+	{0x269B, 0x269C, ExtendedPictographic, conjunctNone},
+	{0x26A0, 0x26A1, ExtendedPictographic, conjunctNone},
+	{0x26A7, 0x26A7, ExtendedPictographic, conjunctNone},
+	{0x26AA, 0x26AB, ExtendedPictographic, conjunctNone},
+	{0x26B0, 0x26B1, ExtendedPictographic, conjunctNone},
+	{0x26BD, 0x26BE, ExtendedPictographic, conjunctNone},
+	{0x26C4, 0x26C5, ExtendedPictographic, conjunctNone},
+	{0x26C8, 0x26C8, ExtendedPictographic, conjunctNone},
+	{0x26CE, 0x26CF, ExtendedPictographic, conjunctNone},
+	{0x26D1, 0x26D1, ExtendedPictographic, conjunctNone},
+	{0x26D3, 0x26D4, ExtendedPictographic, conjunctNone},
+	{0x26E9, 0x26EA, ExtendedPictographic, conjunctNone},
+	{0x26F0, 0x26F5, ExtendedPictographic, conjunctNone},
+	{0x26F7, 0x26FA, ExtendedPictographic, conjunctNone},
+	{0x26FD, 0x26FD, ExtendedPictographic, conjunctNone},
+	{0x2702, 0x2702, ExtendedPictographic, conjunctNone},
+	{0x2705, 0x2705, ExtendedPictographic, conjunctNone},
+	{0x2708, 0x270D, ExtendedPictographic, conjunctNone},
+	{0x270F, 0x270F, ExtendedPictographic, conjunctNone},
+	{0x2712, 0x2712, ExtendedPictographic, conjunctNone},
+	{0x2714, 0x2714, ExtendedPictographic, conjunctNone},
+	{0x2716, 0x2716, ExtendedPictographic, conjunctNone},
+	{0x271D, 0x271D, ExtendedPictographic, conjunctNone},
+	{0x2721, 0x2721, ExtendedPictographic, conjunctNone},
+	{0x2728, 0x2728, ExtendedPictographic, conjunctNone},
+	{0x2733, 0x2734, ExtendedPictographic, conjunctNone},
+	{0x2744, 0x2744, ExtendedPictographic, conjunctNone},
+	{0x2747, 0x2747, ExtendedPictographic, conjunctNone},
+	{0x274C, 0x274C, ExtendedPictographic, conjunctNone},
+	{0x274E, 0x274E, ExtendedPictographic, conjunctNone},
+	{0x2753, 0x2755, ExtendedPictographic, conjunctNone},
+	{0x2757, 0x2757, ExtendedPictographic, conjunctNone},
+	{0x2763, 0x2764, ExtendedPictographic, conjunctNone},
+	{0x2795, 0x2797, ExtendedPictographic, conjunctNone},
+	{0x27A1, 0x27A1, ExtendedPictographic, conjunctNone},
+	{0x27B0, 0x27B0, ExtendedPictographic, conjunctNone},
+	{0x27BF, 0x27BF, ExtendedPictographic, conjunctNone},
+	{0x2934, 0x2935, ExtendedPictographic, conjunctNone},
+	{0x2B05, 0x2B07, ExtendedPictographic, conjunctNone},
+	{0x2B1B, 0x2B1C, ExtendedPictographic, conjunctNone},
+	{0x2B50, 0x2B50, ExtendedPictographic, conjunctNone},
+	{0x2B55, 0x2B55, ExtendedPictographic, conjunctNone},
+	{0x2CEF, 0x2CF1, Extend, conjunctExtend},
+	{0x2D7F, 0x2D7F, Extend, conjunctExtend},
+	{0x2DE0, 0x2DFF, Extend, conjunctExtend},
+	{0x302A, 0x302F, Extend, conjunctExtend},
+	{0x3030, 0x3030, ExtendedPictographic, conjunctNone},
+	{0x303D, 0x303D, ExtendedPictographic, conjunctNone},
+	{0x3099, 0x309A, Extend, conjunctExtend},
+	{0x3297, 0x3297, ExtendedPictographic, conjunctNone},
+	{0x3299, 0x3299, ExtendedPictographic, conjunctNone},
+	{0xA66F, 0xA672, Extend, conjunctExtend},
+	{0xA674, 0xA67D, Extend, conjunctExtend},
+	{0xA69E, 0xA69F, Extend, conjunctExtend},
+	{0xA6F0, 0xA6F1, Extend, conjunctExtend},
+	{0xA802, 0xA802, Extend, conjunctExtend},
+	{0xA806, 0xA806, Extend, conjunctExtend},
+	{0xA80B, 0xA80B, Extend, conjunctExtend},
+	{0xA823, 0xA824, SpacingMark, conjunctNone},
+	{0xA825, 0xA826, Extend, conjunctExtend},
+	{0xA827, 0xA827, SpacingMark, conjunctNone},
+	{0xA82C, 0xA82C, Extend, conjunctExtend},
+	{0xA880, 0xA881, SpacingMark, conjunctNone},
+	{0xA8B4, 0xA8C3, SpacingMark, conjunctNone},
+	{0xA8C4, 0xA8C5, Extend, conjunctExtend},
+	{0xA8E0, 0xA8F1, Extend, conjunctExtend},
+	{0xA8FF, 0xA8FF, Extend, conjunctExtend},
+	{0xA926, 0xA92D, Extend, conjunctExtend},
+	{0xA947, 0xA951, Extend, conjunctExtend},
+	{0xA952, 0xA952, SpacingMark, conjunctNone},
+	{0xA953, 0xA953, Extend, conjunctExtend},
+	{0xA960, 0xA97C, L, conjunctNone},
+	{0xA980, 0xA982, Extend, conjunctExtend},
+	{0xA983, 0xA983, SpacingMark, conjunctNone},
+	{0xA989, 0xA98B, Other, conjunctConsonant},
+	{0xA98F, 0xA9B2, Other, conjunctConsonant},
+	{0xA9B3, 0xA9B3, Extend, conjunctExtend},
+	{0xA9B4, 0xA9B5, SpacingMark, conjunctNone},
+	{0xA9B6, 0xA9B9, Extend, conjunctExtend},
+	{0xA9BA, 0xA9BB, SpacingMark, conjunctNone},
+	{0xA9BC, 0xA9BD, Extend, conjunctExtend},
+	{0xA9BE, 0xA9BF, SpacingMark, conjunctNone},
+	{0xA9C0, 0xA9C0, Extend, conjunctLinker},
+	{0xA9E0, 0xA9E4, Other, conjunctConsonant},
+	{0xA9E5, 0xA9E5, Extend, conjunctExtend},
+	{0xA9E7, 0xA9EF, Other, conjunctConsonant},
+	{0xA9FA, 0xA9FE, Other, conjunctConsonant},
+	{0xAA29, 0xAA2E, Extend, conjunctExtend},
+	{0xAA2F, 0xAA30, SpacingMark, conjunctNone},
+	{0xAA31, 0xAA32, Extend, conjunctExtend},
+	{0xAA33, 0xAA34, SpacingMark, conjunctNone},
+	{0xAA35, 0xAA36, Extend, conjunctExtend},
+	{0xAA43, 0xAA43, Extend, conjunctExtend},
+	{0xAA4C, 0xAA4C, Extend, conjunctExtend},
+	{0xAA4D, 0xAA4D, SpacingMark, conjunctNone},
+	{0xAA60, 0xAA6F, Other, conjunctConsonant},
+	{0xAA71, 0xAA73, Other, conjunctConsonant},
+	{0xAA7A, 0xAA7A, Other, conjunctConsonant},
+	{0xAA7C, 0xAA7C, Extend, conjunctExtend},
+	{0xAA7E, 0xAA7F, Other, conjunctConsonant},
+	{0xAAB0, 0xAAB0, Extend, conjunctExtend},
+	{0xAAB2, 0xAAB4, Extend, conjunctExtend},
+	{0xAAB7, 0xAAB8, Extend, conjunctExtend},
+	{0xAABE, 0xAABF, Extend, conjunctExtend},
+	{0xAAC1, 0xAAC1, Extend, conjunctExtend},
+	{0xAAE0, 0xAAEA, Other, conjunctConsonant},
+	{0xAAEB, 0xAAEB, SpacingMark, conjunctNone},
+	{0xAAEC, 0xAAED, Extend, conjunctExtend},
+	{0xAAEE, 0xAAEF, SpacingMark, conjunctNone},
+	{0xAAF5, 0xAAF5, SpacingMark, conjunctNone},
+	{0xAAF6, 0xAAF6, Extend, conjunctLinker},
+	{0xABC0, 0xABDA, Other, conjunctConsonant},
+	{0xABE3, 0xABE4, SpacingMark, conjunctNone},
+	{0xABE5, 0xABE5, Extend, conjunctExtend},
+	{0xABE6, 0xABE7, SpacingMark, conjunctNone},
+	{0xABE8, 0xABE8, Extend, conjunctExtend},
+	{0xABE9, 0xABEA, SpacingMark, conjunctNone},
+	{0xABEC, 0xABEC, SpacingMark, conjunctNone},
+	{0xABED, 0xABED, Extend, conjunctExtend},
+	{0xAC00, 0xAC00, LV, conjunctNone},
+	{0xAC01, 0xAC1B, LVT, conjunctNone},
+	{0xAC1C, 0xAC1C, LV, conjunctNone},
+	{0xAC1D, 0xAC37, LVT, conjunctNone},
+	{0xAC38, 0xAC38, LV, conjunctNone},
+	{0xAC39, 0xAC53, LVT, conjunctNone},
+	{0xAC54, 0xAC54, LV, conjunctNone},
+	{0xAC55, 0xAC6F, LVT, conjunctNone},
+	{0xAC70, 0xAC70, LV, conjunctNone},
+	{0xAC71, 0xAC8B, LVT, conjunctNone},
+	{0xAC8C, 0xAC8C, LV, conjunctNone},
+	{0xAC8D, 0xACA7, LVT, conjunctNone},
+	{0xACA8, 0xACA8, LV, conjunctNone},
+	{0xACA9, 0xACC3, LVT, conjunctNone},
+	{0xACC4, 0xACC4, LV, conjunctNone},
+	{0xACC5, 0xACDF, LVT, conjunctNone},
+	{0xACE0, 0xACE0, LV, conjunctNone},
+	{0xACE1, 0xACFB, LVT, conjunctNone},
+	{0xACFC, 0xACFC, LV, conjunctNone},
+	{0xACFD, 0xAD17, LVT, conjunctNone},
+	{0xAD18, 0xAD18, LV, conjunctNone},
+	{0xAD19, 0xAD33, LVT, conjunctNone},
+	{0xAD34, 0xAD34, LV, conjunctNone},
+	{0xAD35, 0xAD4F, LVT, conjunctNone},
+	{0xAD50, 0xAD50, LV, conjunctNone},
+	{0xAD51, 0xAD6B, LVT, conjunctNone},
+	{0xAD6C, 0xAD6C, LV, conjunctNone},
+	{0xAD6D, 0xAD87, LVT, conjunctNone},
+	{0xAD88, 0xAD88, LV, conjunctNone},
+	{0xAD89, 0xADA3, LVT, conjunctNone},
+	{0xADA4, 0xADA4, LV, conjunctNone},
+	{0xADA5, 0xADBF, LVT, conjunctNone},
+	{0xADC0, 0xADC0, LV, conjunctNone},
+	{0xADC1, 0xADDB, LVT, conjunctNone},
+	{0xADDC, 0xADDC, LV, conjunctNone},
+	{0xADDD, 0xADF7, LVT, conjunctNone},
+	{0xADF8, 0xADF8, LV, conjunctNone},
+	{0xADF9, 0xAE13, LVT, conjunctNone},
+	{0xAE14, 0xAE14, LV, conjunctNone},
+	{0xAE15, 0xAE2F, LVT, conjunctNone},
+	{0xAE30, 0xAE30, LV, conjunctNone},
+	{0xAE31, 0xAE4B, LVT, conjunctNone},
+	{0xAE4C, 0xAE4C, LV, conjunctNone},
+	{0xAE4D, 0xAE67, LVT, conjunctNone},
+	{0xAE68, 0xAE68, LV, conjunctNone},
+	{0xAE69, 0xAE83, LVT, conjunctNone},
+	{0xAE84, 0xAE84, LV, conjunctNone},
+	{0xAE85, 0xAE9F, LVT, conjunctNone},
+	{0xAEA0, 0xAEA0, LV, conjunctNone},
+	{0xAEA1, 0xAEBB, LVT, conjunctNone},
+	{0xAEBC, 0xAEBC, LV, conjunctNone},
+	{0xAEBD, 0xAED7, LVT, conjunctNone},
+	{0xAED8, 0xAED8, LV, conjunctNone},
+	{0xAED9, 0xAEF3, LVT, conjunctNone},
+	{0xAEF4, 0xAEF4, LV, conjunctNone},
+	{0xAEF5, 0xAF0F, LVT, conjunctNone},
+	{0xAF10, 0xAF10, LV, conjunctNone},
+	{0xAF11, 0xAF2B, LVT, conjunctNone},
+	{0xAF2C, 0xAF2C, LV, conjunctNone},
+	{0xAF2D, 0xAF47, LVT, conjunctNone},
+	{0xAF48, 0xAF48, LV, conjunctNone},
+	{0xAF49, 0xAF63, LVT, conjunctNone},
+	{0xAF64, 0xAF64, LV, conjunctNone},
+	{0xAF65, 0xAF7F, LVT, conjunctNone},
+	{0xAF80, 0xAF80, LV, conjunctNone},
+	{0xAF81, 0xAF9B, LVT, conjunctNone},
+	{0xAF9C, 0xAF9C, LV, conjunctNone},
+	{0xAF9D, 0xAFB7, LVT, conjunctNone},
+	{0xAFB8, 0xAFB8, LV, conjunctNone},
+	{0xAFB9, 0xAFD3, LVT, conjunctNone},
+	{0xAFD4, 0xAFD4, LV, conjunctNone},
+	{0xAFD5, 0xAFEF, LVT, conjunctNone},
+	{0xAFF0, 0xAFF0, LV, conjunctNone},
+	{0xAFF1, 0xB00B, LVT, conjunctNone},
+	{0xB00C, 0xB00C, LV, conjunctNone},
+	{0xB00D, 0xB027, LVT, conjunctNone},
+	{0xB028, 0xB028, LV, conjunctNone},
+	{0xB029, 0xB043, LVT, conjunctNone},
+	{0xB044, 0xB044, LV, conjunctNone},
+	{0xB045, 0xB05F, LVT, conjunctNone},
+	{0xB060, 0xB060, LV, conjunctNone},
+	{0xB061, 0xB07B, LVT, conjunctNone},
+	{0xB07C, 0xB07C, LV, conjunctNone},
+	{0xB07D, 0xB097, LVT, conjunctNone},
+	{0xB098, 0xB098, LV, conjunctNone},
+	{0xB099, 0xB0B3, LVT, conjunctNone},
+	{0xB0B4, 0xB0B4, LV, conjunctNone},
+	{0xB0B5, 0xB0CF, LVT, conjunctNone},
+	{0xB0D0, 0xB0D0, LV, conjunctNone},
+	{0xB0D1, 0xB0EB, LVT, conjunctNone},
+	{0xB0EC, 0xB0EC, LV, conjunctNone},
+	{0xB0ED, 0xB107, LVT, conjunctNone},
+	{0xB108, 0xB108, LV, conjunctNone},
+	{0xB109, 0xB123, LVT, conjunctNone},
+	{0xB124, 0xB124, LV, conjunctNone},
+	{0xB125, 0xB13F, LVT, conjunctNone},
+	{0xB140, 0xB140, LV, conjunctNone},
+	{0xB141, 0xB15B, LVT, conjunctNone},
+	{0xB15C, 0xB15C, LV, conjunctNone},
+	{0xB15D, 0xB177, LVT, conjunctNone},
+	{0xB178, 0xB178, LV, conjunctNone},
+	{0xB179, 0xB193, LVT, conjunctNone},
+	{0xB194, 0xB194, LV, conjunctNone},
+	{0xB195, 0xB1AF, LVT, conjunctNone},
+	{0xB1B0, 0xB1B0, LV, conjunctNone},
+	{0xB1B1, 0xB1CB, LVT, conjunctNone},
+	{0xB1CC, 0xB1CC, LV, conjunctNone},
+	{0xB1CD, 0xB1E7, LVT, conjunctNone},
+	{0xB1E8, 0xB1E8, LV, conjunctNone},
+	{0xB1E9, 0xB203, LVT, conjunctNone},
+	{0xB204, 0xB204, LV, conjunctNone},
+	{0xB205, 0xB21F, LVT, conjunctNone},
+	{0xB220, 0xB220, LV, conjunctNone},
+	{0xB221, 0xB23B, LVT, conjunctNone},
+	{0xB23C, 0xB23C, LV, conjunctNone},
+	{0xB23D, 0xB257, LVT, conjunctNone},
+	{0xB258, 0xB258, LV, conjunctNone},
+	{0xB259, 0xB273, LVT, conjunctNone},
+	{0xB274, 0xB274, LV, conjunctNone},
+	{0xB275, 0xB28F, LVT, conjunctNone},
+	{0xB290, 0xB290, LV, conjunctNone},
+	{0xB291, 0xB2AB, LVT, conjunctNone},
+	{0xB2AC, 0xB2AC, LV, conjunctNone},
+	{0xB2AD, 0xB2C7, LVT, conjunctNone},
+	{0xB2C8, 0xB2C8, LV, conjunctNone},
+	{0xB2C9, 0xB2E3, LVT, conjunctNone},
+	{0xB2E4, 0xB2E4, LV, conjunctNone},
+	{0xB2E5, 0xB2FF, LVT, conjunctNone},
+	{0xB300, 0xB300, LV, conjunctNone},
+	{0xB301, 0xB31B, LVT, conjunctNone},
+	{0xB31C, 0xB31C, LV, conjunctNone},
+	{0xB31D, 0xB337, LVT, conjunctNone},
+	{0xB338, 0xB338, LV, conjunctNone},
+	{0xB339, 0xB353, LVT, conjunctNone},
+	{0xB354, 0xB354, LV, conjunctNone},
+	{0xB355, 0xB36F, LVT, conjunctNone},
+	{0xB370, 0xB370, LV, conjunctNone},
+	{0xB371, 0xB38B, LVT, conjunctNone},
+	{0xB38C, 0xB38C, LV, conjunctNone},
+	{0xB38D, 0xB3A7, LVT, conjunctNone},
+	{0xB3A8, 0xB3A8, LV, conjunctNone},
+	{0xB3A9, 0xB3C3, LVT, conjunctNone},
+	{0xB3C4, 0xB3C4, LV, conjunctNone},
+	{0xB3C5, 0xB3DF, LVT, conjunctNone},
+	{0xB3E0, 0xB3E0, LV, conjunctNone},
+	{0xB3E1, 0xB3FB, LVT, conjunctNone},
+	{0xB3FC, 0xB3FC, LV, conjunctNone},
+	{0xB3FD, 0xB417, LVT, conjunctNone},
+	{0xB418, 0xB418, LV, conjunctNone},
+	{0xB419, 0xB433, LVT, conjunctNone},
+	{0xB434, 0xB434, LV, conjunctNone},
+	{0xB435, 0xB44F, LVT, conjunctNone},
+	{0xB450, 0xB450, LV, conjunctNone},
+	{0xB451, 0xB46B, LVT, conjunctNone},
+	{0xB46C, 0xB46C, LV, conjunctNone},
+	{0xB46D, 0xB487, LVT, conjunctNone},
+	{0xB488, 0xB488, LV, conjunctNone},
+	{0xB489, 0xB4A3, LVT, conjunctNone},
+	{0xB4A4, 0xB4A4, LV, conjunctNone},
+	{0xB4A5, 0xB4BF, LVT, conjunctNone},
+	{0xB4C0, 0xB4C0, LV, conjunctNone},
+	{0xB4C1, 0xB4DB, LVT, conjunctNone},
+	{0xB4DC, 0xB4DC, LV, conjunctNone},
+	{0xB4DD, 0xB4F7, LVT, conjunctNone},
+	{0xB4F8, 0xB4F8, LV, conjunctNone},
+	{0xB4F9, 0xB513, LVT, conjunctNone},
+	{0xB514, 0xB514, LV, conjunctNone},
+	{0xB515, 0xB52F, LVT, conjunctNone},
+	{0xB530, 0xB530, LV, conjunctNone},
+	{0xB531, 0xB54B, LVT, conjunctNone},
+	{0xB54C, 0xB54C, LV, conjunctNone},
+	{0xB54D, 0xB567, LVT, conjunctNone},
+	{0xB568, 0xB568, LV, conjunctNone},
+	{0xB569, 0xB583, LVT, conjunctNone},
+	{0xB584, 0xB584, LV, conjunctNone},
+	{0xB585, 0xB59F, LVT, conjunctNone},
+	{0xB5A0, 0xB5A0, LV, conjunctNone},
+	{0xB5A1, 0xB5BB, LVT, conjunctNone},
+	{0xB5BC, 0xB5BC, LV, conjunctNone},
+	{0xB5BD, 0xB5D7, LVT, conjunctNone},
+	{0xB5D8, 0xB5D8, LV, conjunctNone},
+	{0xB5D9, 0xB5F3, LVT, conjunctNone},
+	{0xB5F4, 0xB5F4, LV, conjunctNone},
+	{0xB5F5, 0xB60F, LVT, conjunctNone},
+	{0xB610, 0xB610, LV, conjunctNone},
+	{0xB611, 0xB62B, LVT, conjunctNone},
+	{0xB62C, 0xB62C, LV, conjunctNone},
+	{0xB62D, 0xB647, LVT, conjunctNone},
+	{0xB648, 0xB648, LV, conjunctNone},
+	{0xB649, 0xB663, LVT, conjunctNone},
+	{0xB664, 0xB664, LV, conjunctNone},
+	{0xB665, 0xB67F, LVT, conjunctNone},
+	{0xB680, 0xB680, LV, conjunctNone},
+	{0xB681, 0xB69B, LVT, conjunctNone},
+	{0xB69C, 0xB69C, LV, conjunctNone},
+	{0xB69D, 0xB6B7, LVT, conjunctNone},
+	{0xB6B8, 0xB6B8, LV, conjunctNone},
+	{0xB6B9, 0xB6D3, LVT, conjunctNone},
+	{0xB6D4, 0xB6D4, LV, conjunctNone},
+	{0xB6D5, 0xB6EF, LVT, conjunctNone},
+	{0xB6F0, 0xB6F0, LV, conjunctNone},
+	{0xB6F1, 0xB70B, LVT, conjunctNone},
+	{0xB70C, 0xB70C, LV, conjunctNone},
+	{0xB70D, 0xB727, LVT, conjunctNone},
+	{0xB728, 0xB728, LV, conjunctNone},
+	{0xB729, 0xB743, LVT, conjunctNone},
+	{0xB744, 0xB744, LV, conjunctNone},
+	{0xB745, 0xB75F, LVT, conjunctNone},
+	{0xB760, 0xB760, LV, conjunctNone},
+	{0xB761, 0xB77B, LVT, conjunctNone},
+	{0xB77C, 0xB77C, LV, conjunctNone},
+	{0xB77D, 0xB797, LVT, conjunctNone},
+	{0xB798, 0xB798, LV, conjunctNone},
+	{0xB799, 0xB7B3, LVT, conjunctNone},
+	{0xB7B4, 0xB7B4, LV, conjunctNone},
+	{0xB7B5, 0xB7CF, LVT, conjunctNone},
+	{0xB7D0, 0xB7D0, LV, conjunctNone},
+	{0xB7D1, 0xB7EB, LVT, conjunctNone},
+	{0xB7EC, 0xB7EC, LV, conjunctNone},
+	{0xB7ED, 0xB807, LVT, conjunctNone},
+	{0xB808, 0xB808, LV, conjunctNone},
+	{0xB809, 0xB823, LVT, conjunctNone},
+	{0xB824, 0xB824, LV, conjunctNone},
+	{0xB825, 0xB83F, LVT, conjunctNone},
+	{0xB840, 0xB840, LV, conjunctNone},
+	{0xB841, 0xB85B, LVT, conjunctNone},
+	{0xB85C, 0xB85C, LV, conjunctNone},
+	{0xB85D, 0xB877, LVT, conjunctNone},
+	{0xB878, 0xB878, LV, conjunctNone},
+	{0xB879, 0xB893, LVT, conjunctNone},
+	{0xB894, 0xB894, LV, conjunctNone},
+	{0xB895, 0xB8AF, LVT, conjunctNone},
+	{0xB8B0, 0xB8B0, LV, conjunctNone},
+	{0xB8B1, 0xB8CB, LVT, conjunctNone},
+	{0xB8CC, 0xB8CC, LV, conjunctNone},
+	{0xB8CD, 0xB8E7, LVT, conjunctNone},
+	{0xB8E8, 0xB8E8, LV, conjunctNone},
+	{0xB8E9, 0xB903, LVT, conjunctNone},
+	{0xB904, 0xB904, LV, conjunctNone},
+	{0xB905, 0xB91F, LVT, conjunctNone},
+	{0xB920, 0xB920, LV, conjunctNone},
+	{0xB921, 0xB93B, LVT, conjunctNone},
+	{0xB93C, 0xB93C, LV, conjunctNone},
+	{0xB93D, 0xB957, LVT, conjunctNone},
+	{0xB958, 0xB958, LV, conjunctNone},
+	{0xB959, 0xB973, LVT, conjunctNone},
+	{0xB974, 0xB974, LV, conjunctNone},
+	{0xB975, 0xB98F, LVT, conjunctNone},
+	{0xB990, 0xB990, LV, conjunctNone},
+	{0xB991, 0xB9AB, LVT, conjunctNone},
+	{0xB9AC, 0xB9AC, LV, conjunctNone},
+	{0xB9AD, 0xB9C7, LVT, conjunctNone},
+	{0xB9C8, 0xB9C8, LV, conjunctNone},
+	{0xB9C9, 0xB9E3, LVT, conjunctNone},
+	{0xB9E4, 0xB9E4, LV, conjunctNone},
+	{0xB9E5, 0xB9FF, LVT, conjunctNone},
+	{0xBA00, 0xBA00, LV, conjunctNone},
+	{0xBA01, 0xBA1B, LVT, conjunctNone},
+	{0xBA1C, 0xBA1C, LV, conjunctNone},
+	{0xBA1D, 0xBA37, LVT, conjunctNone},
+	{0xBA38, 0xBA38, LV, conjunctNone},
+	{0xBA39, 0xBA53, LVT, conjunctNone},
+	{0xBA54, 0xBA54, LV, conjunctNone},
+	{0xBA55, 0xBA6F, LVT, conjunctNone},
+	{0xBA70, 0xBA70, LV, conjunctNone},
+	{0xBA71, 0xBA8B, LVT, conjunctNone},
+	{0xBA8C, 0xBA8C, LV, conjunctNone},
+	{0xBA8D, 0xBAA7, LVT, conjunctNone},
+	{0xBAA8, 0xBAA8, LV, conjunctNone},
+	{0xBAA9, 0xBAC3, LVT, conjunctNone},
+	{0xBAC4, 0xBAC4, LV, conjunctNone},
+	{0xBAC5, 0xBADF, LVT, conjunctNone},
+	{0xBAE0, 0xBAE0, LV, conjunctNone},
+	{0xBAE1, 0xBAFB, LVT, conjunctNone},
+	{0xBAFC, 0xBAFC, LV, conjunctNone},
+	{0xBAFD, 0xBB17, LVT, conjunctNone},
+	{0xBB18, 0xBB18, LV, conjunctNone},
+	{0xBB19, 0xBB33, LVT, conjunctNone},
+	{0xBB34, 0xBB34, LV, conjunctNone},
+	{0xBB35, 0xBB4F, LVT, conjunctNone},
+	{0xBB50, 0xBB50, LV, conjunctNone},
+	{0xBB51, 0xBB6B, LVT, conjunctNone},
+	{0xBB6C, 0xBB6C, LV, conjunctNone},
+	{0xBB6D, 0xBB87, LVT, conjunctNone},
+	{0xBB88, 0xBB88, LV, conjunctNone},
+	{0xBB89, 0xBBA3, LVT, conjunctNone},
+	{0xBBA4, 0xBBA4, LV, conjunctNone},
+	{0xBBA5, 0xBBBF, LVT, conjunctNone},
+	{0xBBC0, 0xBBC0, LV, conjunctNone},
+	{0xBBC1, 0xBBDB, LVT, conjunctNone},
+	{0xBBDC, 0xBBDC, LV, conjunctNone},
+	{0xBBDD, 0xBBF7, LVT, conjunctNone},
+	{0xBBF8, 0xBBF8, LV, conjunctNone},
+	{0xBBF9, 0xBC13, LVT, conjunctNone},
+	{0xBC14, 0xBC14, LV, conjunctNone},
+	{0xBC15, 0xBC2F, LVT, conjunctNone},
+	{0xBC30, 0xBC30, LV, conjunctNone},
+	{0xBC31, 0xBC4B, LVT, conjunctNone},
+	{0xBC4C, 0xBC4C, LV, conjunctNone},
+	{0xBC4D, 0xBC67, LVT, conjunctNone},
+	{0xBC68, 0xBC68, LV, conjunctNone},
+	{0xBC69, 0xBC83, LVT, conjunctNone},
+	{0xBC84, 0xBC84, LV, conjunctNone},
+	{0xBC85, 0xBC9F, LVT, conjunctNone},
+	{0xBCA0, 0xBCA0, LV, conjunctNone},
+	{0xBCA1, 0xBCBB, LVT, conjunctNone},
+	{0xBCBC, 0xBCBC, LV, conjunctNone},
+	{0xBCBD, 0xBCD7, LVT, conjunctNone},
+	{0xBCD8, 0xBCD8, LV, conjunctNone},
+	{0xBCD9, 0xBCF3, LVT, conjunctNone},
+	{0xBCF4, 0xBCF4, LV, conjunctNone},
+	{0xBCF5, 0xBD0F, LVT, conjunctNone},
+	{0xBD10, 0xBD10, LV, conjunctNone},
+	{0xBD11, 0xBD2B, LVT, conjunctNone},
+	{0xBD2C, 0xBD2C, LV, conjunctNone},
+	{0xBD2D, 0xBD47, LVT, conjunctNone},
+	{0xBD48, 0xBD48, LV, conjunctNone},
+	{0xBD49, 0xBD63, LVT, conjunctNone},
+	{0xBD64, 0xBD64, LV, conjunctNone},
+	{0xBD65, 0xBD7F, LVT, conjunctNone},
+	{0xBD80, 0xBD80, LV, conjunctNone},
+	{0xBD81, 0xBD9B, LVT, conjunctNone},
+	{0xBD9C, 0xBD9C, LV, conjunctNone},
+	{0xBD9D, 0xBDB7, LVT, conjunctNone},
+	{0xBDB8, 0xBDB8, LV, conjunctNone},
+	{0xBDB9, 0xBDD3, LVT, conjunctNone},
+	{0xBDD4, 0xBDD4, LV, conjunctNone},
+	{0xBDD5, 0xBDEF, LVT, conjunctNone},
+	{0xBDF0, 0xBDF0, LV, conjunctNone},
+	{0xBDF1, 0xBE0B, LVT, conjunctNone},
+	{0xBE0C, 0xBE0C, LV, conjunctNone},
+	{0xBE0D, 0xBE27, LVT, conjunctNone},
+	{0xBE28, 0xBE28, LV, conjunctNone},
+	{0xBE29, 0xBE43, LVT, conjunctNone},
+	{0xBE44, 0xBE44, LV, conjunctNone},
+	{0xBE45, 0xBE5F, LVT, conjunctNone},
+	{0xBE60, 0xBE60, LV, conjunctNone},
+	{0xBE61, 0xBE7B, LVT, conjunctNone},
+	{0xBE7C, 0xBE7C, LV, conjunctNone},
+	{0xBE7D, 0xBE97, LVT, conjunctNone},
+	{0xBE98, 0xBE98, LV, conjunctNone},
+	{0xBE99, 0xBEB3, LVT, conjunctNone},
+	{0xBEB4, 0xBEB4, LV, conjunctNone},
+	{0xBEB5, 0xBECF, LVT, conjunctNone},
+	{0xBED0, 0xBED0, LV, conjunctNone},
+	{0xBED1, 0xBEEB, LVT, conjunctNone},
+	{0xBEEC, 0xBEEC, LV, conjunctNone},
+	{0xBEED, 0xBF07, LVT, conjunctNone},
+	{0xBF08, 0xBF08, LV, conjunctNone},
+	{0xBF09, 0xBF23, LVT, conjunctNone},
+	{0xBF24, 0xBF24, LV, conjunctNone},
+	{0xBF25, 0xBF3F, LVT, conjunctNone},
+	{0xBF40, 0xBF40, LV, conjunctNone},
+	{0xBF41, 0xBF5B, LVT, conjunctNone},
+	{0xBF5C, 0xBF5C, LV, conjunctNone},
+	{0xBF5D, 0xBF77, LVT, conjunctNone},
+	{0xBF78, 0xBF78, LV, conjunctNone},
+	{0xBF79, 0xBF93, LVT, conjunctNone},
+	{0xBF94, 0xBF94, LV, conjunctNone},
+	{0xBF95, 0xBFAF, LVT, conjunctNone},
+	{0xBFB0, 0xBFB0, LV, conjunctNone},
+	{0xBFB1, 0xBFCB, LVT, conjunctNone},
+	{0xBFCC, 0xBFCC, LV, conjunctNone},
+	{0xBFCD, 0xBFE7, LVT, conjunctNone},
+	{0xBFE8, 0xBFE8, LV, conjunctNone},
+	{0xBFE9, 0xC003, LVT, conjunctNone},
+	{0xC004, 0xC004, LV, conjunctNone},
+	{0xC005, 0xC01F, LVT, conjunctNone},
+	{0xC020, 0xC020, LV, conjunctNone},
+	{0xC021, 0xC03B, LVT, conjunctNone},
+	{0xC03C, 0xC03C, LV, conjunctNone},
+	{0xC03D, 0xC057, LVT, conjunctNone},
+	{0xC058, 0xC058, LV, conjunctNone},
+	{0xC059, 0xC073, LVT, conjunctNone},
+	{0xC074, 0xC074, LV, conjunctNone},
+	{0xC075, 0xC08F, LVT, conjunctNone},
+	{0xC090, 0xC090, LV, conjunctNone},
+	{0xC091, 0xC0AB, LVT, conjunctNone},
+	{0xC0AC, 0xC0AC, LV, conjunctNone},
+	{0xC0AD, 0xC0C7, LVT, conjunctNone},
+	{0xC0C8, 0xC0C8, LV, conjunctNone},
+	{0xC0C9, 0xC0E3, LVT, conjunctNone},
+	{0xC0E4, 0xC0E4, LV, conjunctNone},
+	{0xC0E5, 0xC0FF, LVT, conjunctNone},
+	{0xC100, 0xC100, LV, conjunctNone},
+	{0xC101, 0xC11B, LVT, conjunctNone},
+	{0xC11C, 0xC11C, LV, conjunctNone},
+	{0xC11D, 0xC137, LVT, conjunctNone},
+	{0xC138, 0xC138, LV, conjunctNone},
+	{0xC139, 0xC153, LVT, conjunctNone},
+	{0xC154, 0xC154, LV, conjunctNone},
+	{0xC155, 0xC16F, LVT, conjunctNone},
+	{0xC170, 0xC170, LV, conjunctNone},
+	{0xC171, 0xC18B, LVT, conjunctNone},
+	{0xC18C, 0xC18C, LV, conjunctNone},
+	{0xC18D, 0xC1A7, LVT, conjunctNone},
+	{0xC1A8, 0xC1A8, LV, conjunctNone},
+	{0xC1A9, 0xC1C3, LVT, conjunctNone},
+	{0xC1C4, 0xC1C4, LV, conjunctNone},
+	{0xC1C5, 0xC1DF, LVT, conjunctNone},
+	{0xC1E0, 0xC1E0, LV, conjunctNone},
+	{0xC1E1, 0xC1FB, LVT, conjunctNone},
+	{0xC1FC, 0xC1FC, LV, conjunctNone},
+	{0xC1FD, 0xC217, LVT, conjunctNone},
+	{0xC218, 0xC218, LV, conjunctNone},
+	{0xC219, 0xC233, LVT, conjunctNone},
+	{0xC234, 0xC234, LV, conjunctNone},
+	{0xC235, 0xC24F, LVT, conjunctNone},
+	{0xC250, 0xC250, LV, conjunctNone},
+	{0xC251, 0xC26B, LVT, conjunctNone},
+	{0xC26C, 0xC26C, LV, conjunctNone},
+	{0xC26D, 0xC287, LVT, conjunctNone},
+	{0xC288, 0xC288, LV, conjunctNone},
+	{0xC289, 0xC2A3, LVT, conjunctNone},
+	{0xC2A4, 0xC2A4, LV, conjunctNone},
+	{0xC2A5, 0xC2BF, LVT, conjunctNone},
+	{0xC2C0, 0xC2C0, LV, conjunctNone},
+	{0xC2C1, 0xC2DB, LVT, conjunctNone},
+	{0xC2DC, 0xC2DC, LV, conjunctNone},
+	{0xC2DD, 0xC2F7, LVT, conjunctNone},
+	{0xC2F8, 0xC2F8, LV, conjunctNone},
+	{0xC2F9, 0xC313, LVT, conjunctNone},
+	{0xC314, 0xC314, LV, conjunctNone},
+	{0xC315, 0xC32F, LVT, conjunctNone},
+	{0xC330, 0xC330, LV, conjunctNone},
+	{0xC331, 0xC34B, LVT, conjunctNone},
+	{0xC34C, 0xC34C, LV, conjunctNone},
+	{0xC34D, 0xC367, LVT, conjunctNone},
+	{0xC368, 0xC368, LV, conjunctNone},
+	{0xC369, 0xC383, LVT, conjunctNone},
+	{0xC384, 0xC384, LV, conjunctNone},
+	{0xC385, 0xC39F, LVT, conjunctNone},
+	{0xC3A0, 0xC3A0, LV, conjunctNone},
+	{0xC3A1, 0xC3BB, LVT, conjunctNone},
+	{0xC3BC, 0xC3BC, LV, conjunctNone},
+	{0xC3BD, 0xC3D7, LVT, conjunctNone},
+	{0xC3D8, 0xC3D8, LV, conjunctNone},
+	{0xC3D9, 0xC3F3, LVT, conjunctNone},
+	{0xC3F4, 0xC3F4, LV, conjunctNone},
+	{0xC3F5, 0xC40F, LVT, conjunctNone},
+	{0xC410, 0xC410, LV, conjunctNone},
+	{0xC411, 0xC42B, LVT, conjunctNone},
+	{0xC42C, 0xC42C, LV, conjunctNone},
+	{0xC42D, 0xC447, LVT, conjunctNone},
+	{0xC448, 0xC448, LV, conjunctNone},
+	{0xC449, 0xC463, LVT, conjunctNone},
+	{0xC464, 0xC464, LV, conjunctNone},
+	{0xC465, 0xC47F, LVT, conjunctNone},
+	{0xC480, 0xC480, LV, conjunctNone},
+	{0xC481, 0xC49B, LVT, conjunctNone},
+	{0xC49C, 0xC49C, LV, conjunctNone},
+	{0xC49D, 0xC4B7, LVT, conjunctNone},
+	{0xC4B8, 0xC4B8, LV, conjunctNone},
+	{0xC4B9, 0xC4D3, LVT, conjunctNone},
+	{0xC4D4, 0xC4D4, LV, conjunctNone},
+	{0xC4D5, 0xC4EF, LVT, conjunctNone},
+	{0xC4F0, 0xC4F0, LV, conjunctNone},
+	{0xC4F1, 0xC50B, LVT, conjunctNone},
+	{0xC50C, 0xC50C, LV, conjunctNone},
+	{0xC50D, 0xC527, LVT, conjunctNone},
+	{0xC528, 0xC528, LV, conjunctNone},
+	{0xC529, 0xC543, LVT, conjunctNone},
+	{0xC544, 0xC544, LV, conjunctNone},
+	{0xC545, 0xC55F, LVT, conjunctNone},
+	{0xC560, 0xC560, LV, conjunctNone},
+	{0xC561, 0xC57B, LVT, conjunctNone},
+	{0xC57C, 0xC57C, LV, conjunctNone},
+	{0xC57D, 0xC597, LVT, conjunctNone},
+	{0xC598, 0xC598, LV, conjunctNone},
+	{0xC599, 0xC5B3, LVT, conjunctNone},
+	{0xC5B4, 0xC5B4, LV, conjunctNone},
+	{0xC5B5, 0xC5CF, LVT, conjunctNone},
+	{0xC5D0, 0xC5D0, LV, conjunctNone},
+	{0xC5D1, 0xC5EB, LVT, conjunctNone},
+	{0xC5EC, 0xC5EC, LV, conjunctNone},
+	{0xC5ED, 0xC607, LVT, conjunctNone},
+	{0xC608, 0xC608, LV, conjunctNone},
+	{0xC609, 0xC623, LVT, conjunctNone},
+	{0xC624, 0xC624, LV, conjunctNone},
+	{0xC625, 0xC63F, LVT, conjunctNone},
+	{0xC640, 0xC640, LV, conjunctNone},
+	{0xC641, 0xC65B, LVT, conjunctNone},
+	{0xC65C, 0xC65C, LV, conjunctNone},
+	{0xC65D, 0xC677, LVT, conjunctNone},
+	{0xC678, 0xC678, LV, conjunctNone},
+	{0xC679, 0xC693, LVT, conjunctNone},
+	{0xC694, 0xC694, LV, conjunctNone},
+	{0xC695, 0xC6AF, LVT, conjunctNone},
+	{0xC6B0, 0xC6B0, LV, conjunctNone},
+	{0xC6B1, 0xC6CB, LVT, conjunctNone},
+	{0xC6CC, 0xC6CC, LV, conjunctNone},
+	{0xC6CD, 0xC6E7, LVT, conjunctNone},
+	{0xC6E8, 0xC6E8, LV, conjunctNone},
+	{0xC6E9, 0xC703, LVT, conjunctNone},
+	{0xC704, 0xC704, LV, conjunctNone},
+	{0xC705, 0xC71F, LVT, conjunctNone},
+	{0xC720, 0xC720, LV, conjunctNone},
+	{0xC721, 0xC73B, LVT, conjunctNone},
+	{0xC73C, 0xC73C, LV, conjunctNone},
+	{0xC73D, 0xC757, LVT, conjunctNone},
+	{0xC758, 0xC758, LV, conjunctNone},
+	{0xC759, 0xC773, LVT, conjunctNone},
+	{0xC774, 0xC774, LV, conjunctNone},
+	{0xC775, 0xC78F, LVT, conjunctNone},
+	{0xC790, 0xC790, LV, conjunctNone},
+	{0xC791, 0xC7AB, LVT, conjunctNone},
+	{0xC7AC, 0xC7AC, LV, conjunctNone},
+	{0xC7AD, 0xC7C7, LVT, conjunctNone},
+	{0xC7C8, 0xC7C8, LV, conjunctNone},
+	{0xC7C9, 0xC7E3, LVT, conjunctNone},
+	{0xC7E4, 0xC7E4, LV, conjunctNone},
+	{0xC7E5, 0xC7FF, LVT, conjunctNone},
+	{0xC800, 0xC800, LV, conjunctNone},
+	{0xC801, 0xC81B, LVT, conjunctNone},
+	{0xC81C, 0xC81C, LV, conjunctNone},
+	{0xC81D, 0xC837, LVT, conjunctNone},
+	{0xC838, 0xC838, LV, conjunctNone},
+	{0xC839, 0xC853, LVT, conjunctNone},
+	{0xC854, 0xC854, LV, conjunctNone},
+	{0xC855, 0xC86F, LVT, conjunctNone},
+	{0xC870, 0xC870, LV, conjunctNone},
+	{0xC871, 0xC88B, LVT, conjunctNone},
+	{0xC88C, 0xC88C, LV, conjunctNone},
+	{0xC88D, 0xC8A7, LVT, conjunctNone},
+	{0xC8A8, 0xC8A8, LV, conjunctNone},
+	{0xC8A9, 0xC8C3, LVT, conjunctNone},
+	{0xC8C4, 0xC8C4, LV, conjunctNone},
+	{0xC8C5, 0xC8DF, LVT, conjunctNone},
+	{0xC8E0, 0xC8E0, LV, conjunctNone},
+	{0xC8E1, 0xC8FB, LVT, conjunctNone},
+	{0xC8FC, 0xC8FC, LV, conjunctNone},
+	{0xC8FD, 0xC917, LVT, conjunctNone},
+	{0xC918, 0xC918, LV, conjunctNone},
+	{0xC919, 0xC933, LVT, conjunctNone},
+	{0xC934, 0xC934, LV, conjunctNone},
+	{0xC935, 0xC94F, LVT, conjunctNone},
+	{0xC950, 0xC950, LV, conjunctNone},
+	{0xC951, 0xC96B, LVT, conjunctNone},
+	{0xC96C, 0xC96C, LV, conjunctNone},
+	{0xC96D, 0xC987, LVT, conjunctNone},
+	{0xC988, 0xC988, LV, conjunctNone},
+	{0xC989, 0xC9A3, LVT, conjunctNone},
+	{0xC9A4, 0xC9A4, LV, conjunctNone},
+	{0xC9A5, 0xC9BF, LVT, conjunctNone},
+	{0xC9C0, 0xC9C0, LV, conjunctNone},
+	{0xC9C1, 0xC9DB, LVT, conjunctNone},
+	{0xC9DC, 0xC9DC, LV, conjunctNone},
+	{0xC9DD, 0xC9F7, LVT, conjunctNone},
+	{0xC9F8, 0xC9F8, LV, conjunctNone},
+	{0xC9F9, 0xCA13, LVT, conjunctNone},
+	{0xCA14, 0xCA14, LV, conjunctNone},
+	{0xCA15, 0xCA2F, LVT, conjunctNone},
+	{0xCA30, 0xCA30, LV, conjunctNone},
+	{0xCA31, 0xCA4B, LVT, conjunctNone},
+	{0xCA4C, 0xCA4C, LV, conjunctNone},
+	{0xCA4D, 0xCA67, LVT, conjunctNone},
+	{0xCA68, 0xCA68, LV, conjunctNone},
+	{0xCA69, 0xCA83, LVT, conjunctNone},
+	{0xCA84, 0xCA84, LV, conjunctNone},
+	{0xCA85, 0xCA9F, LVT, conjunctNone},
+	{0xCAA0, 0xCAA0, LV, conjunctNone},
+	{0xCAA1, 0xCABB, LVT, conjunctNone},
+	{0xCABC, 0xCABC, LV, conjunctNone},
+	{0xCABD, 0xCAD7, LVT, conjunctNone},
+	{0xCAD8, 0xCAD8, LV, conjunctNone},
+	{0xCAD9, 0xCAF3, LVT, conjunctNone},
+	{0xCAF4, 0xCAF4, LV, conjunctNone},
+	{0xCAF5, 0xCB0F, LVT, conjunctNone},
+	{0xCB10, 0xCB10, LV, conjunctNone},
+	{0xCB11, 0xCB2B, LVT, conjunctNone},
+	{0xCB2C, 0xCB2C, LV, conjunctNone},
+	{0xCB2D, 0xCB47, LVT, conjunctNone},
+	{0xCB48, 0xCB48, LV, conjunctNone},
+	{0xCB49, 0xCB63, LVT, conjunctNone},
+	{0xCB64, 0xCB64, LV, conjunctNone},
+	{0xCB65, 0xCB7F, LVT, conjunctNone},
+	{0xCB80, 0xCB80, LV, conjunctNone},
+	{0xCB81, 0xCB9B, LVT, conjunctNone},
+	{0xCB9C, 0xCB9C, LV, conjunctNone},
+	{0xCB9D, 0xCBB7, LVT, conjunctNone},
+	{0xCBB8, 0xCBB8, LV, conjunctNone},
+	{0xCBB9, 0xCBD3, LVT, conjunctNone},
+	{0xCBD4, 0xCBD4, LV, conjunctNone},
+	{0xCBD5, 0xCBEF, LVT, conjunctNone},
+	{0xCBF0, 0xCBF0, LV, conjunctNone},
+	{0xCBF1, 0xCC0B, LVT, conjunctNone},
+	{0xCC0C, 0xCC0C, LV, conjunctNone},
+	{0xCC0D, 0xCC27, LVT, conjunctNone},
+	{0xCC28, 0xCC28, LV, conjunctNone},
+	{0xCC29, 0xCC43, LVT, conjunctNone},
+	{0xCC44, 0xCC44, LV, conjunctNone},
+	{0xCC45, 0xCC5F, LVT, conjunctNone},
+	{0xCC60, 0xCC60, LV, conjunctNone},
+	{0xCC61, 0xCC7B, LVT, conjunctNone},
+	{0xCC7C, 0xCC7C, LV, conjunctNone},
+	{0xCC7D, 0xCC97, LVT, conjunctNone},
+	{0xCC98, 0xCC98, LV, conjunctNone},
+	{0xCC99, 0xCCB3, LVT, conjunctNone},
+	{0xCCB4, 0xCCB4, LV, conjunctNone},
+	{0xCCB5, 0xCCCF, LVT, conjunctNone},
+	{0xCCD0, 0xCCD0, LV, conjunctNone},
+	{0xCCD1, 0xCCEB, LVT, conjunctNone},
+	{0xCCEC, 0xCCEC, LV, conjunctNone},
+	{0xCCED, 0xCD07, LVT, conjunctNone},
+	{0xCD08, 0xCD08, LV, conjunctNone},
+	{0xCD09, 0xCD23, LVT, conjunctNone},
+	{0xCD24, 0xCD24, LV, conjunctNone},
+	{0xCD25, 0xCD3F, LVT, conjunctNone},
+	{0xCD40, 0xCD40, LV, conjunctNone},
+	{0xCD41, 0xCD5B, LVT, conjunctNone},
+	{0xCD5C, 0xCD5C, LV, conjunctNone},
+	{0xCD5D, 0xCD77, LVT, conjunctNone},
+	{0xCD78, 0xCD78, LV, conjunctNone},
+	{0xCD79, 0xCD93, LVT, conjunctNone},
+	{0xCD94, 0xCD94, LV, conjunctNone},
+	{0xCD95, 0xCDAF, LVT, conjunctNone},
+	{0xCDB0, 0xCDB0, LV, conjunctNone},
+	{0xCDB1, 0xCDCB, LVT, conjunctNone},
+	{0xCDCC, 0xCDCC, LV, conjunctNone},
+	{0xCDCD, 0xCDE7, LVT, conjunctNone},
+	{0xCDE8, 0xCDE8, LV, conjunctNone},
+	{0xCDE9, 0xCE03, LVT, conjunctNone},
+	{0xCE04, 0xCE04, LV, conjunctNone},
+	{0xCE05, 0xCE1F, LVT, conjunctNone},
+	{0xCE20, 0xCE20, LV, conjunctNone},
+	{0xCE21, 0xCE3B, LVT, conjunctNone},
+	{0xCE3C, 0xCE3C, LV, conjunctNone},
+	{0xCE3D, 0xCE57, LVT, conjunctNone},
+	{0xCE58, 0xCE58, LV, conjunctNone},
+	{0xCE59, 0xCE73, LVT, conjunctNone},
+	{0xCE74, 0xCE74, LV, conjunctNone},
+	{0xCE75, 0xCE8F, LVT, conjunctNone},
+	{0xCE90, 0xCE90, LV, conjunctNone},
+	{0xCE91, 0xCEAB, LVT, conjunctNone},
+	{0xCEAC, 0xCEAC, LV, conjunctNone},
+	{0xCEAD, 0xCEC7, LVT, conjunctNone},
+	{0xCEC8, 0xCEC8, LV, conjunctNone},
+	{0xCEC9, 0xCEE3, LVT, conjunctNone},
+	{0xCEE4, 0xCEE4, LV, conjunctNone},
+	{0xCEE5, 0xCEFF, LVT, conjunctNone},
+	{0xCF00, 0xCF00, LV, conjunctNone},
+	{0xCF01, 0xCF1B, LVT, conjunctNone},
+	{0xCF1C, 0xCF1C, LV, conjunctNone},
+	{0xCF1D, 0xCF37, LVT, conjunctNone},
+	{0xCF38, 0xCF38, LV, conjunctNone},
+	{0xCF39, 0xCF53, LVT, conjunctNone},
+	{0xCF54, 0xCF54, LV, conjunctNone},
+	{0xCF55, 0xCF6F, LVT, conjunctNone},
+	{0xCF70, 0xCF70, LV, conjunctNone},
+	{0xCF71, 0xCF8B, LVT, conjunctNone},
+	{0xCF8C, 0xCF8C, LV, conjunctNone},
+	{0xCF8D, 0xCFA7, LVT, conjunctNone},
+	{0xCFA8, 0xCFA8, LV, conjunctNone},
+	{0xCFA9, 0xCFC3, LVT, conjunctNone},
+	{0xCFC4, 0xCFC4, LV, conjunctNone},
+	{0xCFC5, 0xCFDF, LVT, conjunctNone},
+	{0xCFE0, 0xCFE0, LV, conjunctNone},
+	{0xCFE1, 0xCFFB, LVT, conjunctNone},
+	{0xCFFC, 0xCFFC, LV, conjunctNone},
+	{0xCFFD, 0xD017, LVT, conjunctNone},
+	{0xD018, 0xD018, LV, conjunctNone},
+	{0xD019, 0xD033, LVT, conjunctNone},
+	{0xD034, 0xD034, LV, conjunctNone},
+	{0xD035, 0xD04F, LVT, conjunctNone},
+	{0xD050, 0xD050, LV, conjunctNone},
+	{0xD051, 0xD06B, LVT, conjunctNone},
+	{0xD06C, 0xD06C, LV, conjunctNone},
+	{0xD06D, 0xD087, LVT, conjunctNone},
+	{0xD088, 0xD088, LV, conjunctNone},
+	{0xD089, 0xD0A3, LVT, conjunctNone},
+	{0xD0A4, 0xD0A4, LV, conjunctNone},
+	{0xD0A5, 0xD0BF, LVT, conjunctNone},
+	{0xD0C0, 0xD0C0, LV, conjunctNone},
+	{0xD0C1, 0xD0DB, LVT, conjunctNone},
+	{0xD0DC, 0xD0DC, LV, conjunctNone},
+	{0xD0DD, 0xD0F7, LVT, conjunctNone},
+	{0xD0F8, 0xD0F8, LV, conjunctNone},
+	{0xD0F9, 0xD113, LVT, conjunctNone},
+	{0xD114, 0xD114, LV, conjunctNone},
+	{0xD115, 0xD12F, LVT, conjunctNone},
+	{0xD130, 0xD130, LV, conjunctNone},
+	{0xD131, 0xD14B, LVT, conjunctNone},
+	{0xD14C, 0xD14C, LV, conjunctNone},
+	{0xD14D, 0xD167, LVT, conjunctNone},
+	{0xD168, 0xD168, LV, conjunctNone},
+	{0xD169, 0xD183, LVT, conjunctNone},
+	{0xD184, 0xD184, LV, conjunctNone},
+	{0xD185, 0xD19F, LVT, conjunctNone},
+	{0xD1A0, 0xD1A0, LV, conjunctNone},
+	{0xD1A1, 0xD1BB, LVT, conjunctNone},
+	{0xD1BC, 0xD1BC, LV, conjunctNone},
+	{0xD1BD, 0xD1D7, LVT, conjunctNone},
+	{0xD1D8, 0xD1D8, LV, conjunctNone},
+	{0xD1D9, 0xD1F3, LVT, conjunctNone},
+	{0xD1F4, 0xD1F4, LV, conjunctNone},
+	{0xD1F5, 0xD20F, LVT, conjunctNone},
+	{0xD210, 0xD210, LV, conjunctNone},
+	{0xD211, 0xD22B, LVT, conjunctNone},
+	{0xD22C, 0xD22C, LV, conjunctNone},
+	{0xD22D, 0xD247, LVT, conjunctNone},
+	{0xD248, 0xD248, LV, conjunctNone},
+	{0xD249, 0xD263, LVT, conjunctNone},
+	{0xD264, 0xD264, LV, conjunctNone},
+	{0xD265, 0xD27F, LVT, conjunctNone},
+	{0xD280, 0xD280, LV, conjunctNone},
+	{0xD281, 0xD29B, LVT, conjunctNone},
+	{0xD29C, 0xD29C, LV, conjunctNone},
+	{0xD29D, 0xD2B7, LVT, conjunctNone},
+	{0xD2B8, 0xD2B8, LV, conjunctNone},
+	{0xD2B9, 0xD2D3, LVT, conjunctNone},
+	{0xD2D4, 0xD2D4, LV, conjunctNone},
+	{0xD2D5, 0xD2EF, LVT, conjunctNone},
+	{0xD2F0, 0xD2F0, LV, conjunctNone},
+	{0xD2F1, 0xD30B, LVT, conjunctNone},
+	{0xD30C, 0xD30C, LV, conjunctNone},
+	{0xD30D, 0xD327, LVT, conjunctNone},
+	{0xD328, 0xD328, LV, conjunctNone},
+	{0xD329, 0xD343, LVT, conjunctNone},
+	{0xD344, 0xD344, LV, conjunctNone},
+	{0xD345, 0xD35F, LVT, conjunctNone},
+	{0xD360, 0xD360, LV, conjunctNone},
+	{0xD361, 0xD37B, LVT, conjunctNone},
+	{0xD37C, 0xD37C, LV, conjunctNone},
+	{0xD37D, 0xD397, LVT, conjunctNone},
+	{0xD398, 0xD398, LV, conjunctNone},
+	{0xD399, 0xD3B3, LVT, conjunctNone},
+	{0xD3B4, 0xD3B4, LV, conjunctNone},
+	{0xD3B5, 0xD3CF, LVT, conjunctNone},
+	{0xD3D0, 0xD3D0, LV, conjunctNone},
+	{0xD3D1, 0xD3EB, LVT, conjunctNone},
+	{0xD3EC, 0xD3EC, LV, conjunctNone},
+	{0xD3ED, 0xD407, LVT, conjunctNone},
+	{0xD408, 0xD408, LV, conjunctNone},
+	{0xD409, 0xD423, LVT, conjunctNone},
+	{0xD424, 0xD424, LV, conjunctNone},
+	{0xD425, 0xD43F, LVT, conjunctNone},
+	{0xD440, 0xD440, LV, conjunctNone},
+	{0xD441, 0xD45B, LVT, conjunctNone},
+	{0xD45C, 0xD45C, LV, conjunctNone},
+	{0xD45D, 0xD477, LVT, conjunctNone},
+	{0xD478, 0xD478, LV, conjunctNone},
+	{0xD479, 0xD493, LVT, conjunctNone},
+	{0xD494, 0xD494, LV, conjunctNone},
+	{0xD495, 0xD4AF, LVT, conjunctNone},
+	{0xD4B0, 0xD4B0, LV, conjunctNone},
+	{0xD4B1, 0xD4CB, LVT, conjunctNone},
+	{0xD4CC, 0xD4CC, LV, conjunctNone},
+	{0xD4CD, 0xD4E7, LVT, conjunctNone},
+	{0xD4E8, 0xD4E8, LV, conjunctNone},
+	{0xD4E9, 0xD503, LVT, conjunctNone},
+	{0xD504, 0xD504, LV, conjunctNone},
+	{0xD505, 0xD51F, LVT, conjunctNone},
+	{0xD520, 0xD520, LV, conjunctNone},
+	{0xD521, 0xD53B, LVT, conjunctNone},
+	{0xD53C, 0xD53C, LV, conjunctNone},
+	{0xD53D, 0xD557, LVT, conjunctNone},
+	{0xD558, 0xD558, LV, conjunctNone},
+	{0xD559, 0xD573, LVT, conjunctNone},
+	{0xD574, 0xD574, LV, conjunctNone},
+	{0xD575, 0xD58F, LVT, conjunctNone},
+	{0xD590, 0xD590, LV, conjunctNone},
+	{0xD591, 0xD5AB, LVT, conjunctNone},
+	{0xD5AC, 0xD5AC, LV, conjunctNone},
+	{0xD5AD, 0xD5C7, LVT, conjunctNone},
+	{0xD5C8, 0xD5C8, LV, conjunctNone},
+	{0xD5C9, 0xD5E3, LVT, conjunctNone},
+	{0xD5E4, 0xD5E4, LV, conjunctNone},
+	{0xD5E5, 0xD5FF, LVT, conjunctNone},
+	{0xD600, 0xD600, LV, conjunctNone},
+	{0xD601, 0xD61B, LVT, conjunctNone},
+	{0xD61C, 0xD61C, LV, conjunctNone},
+	{0xD61D, 0xD637, LVT, conjunctNone},
+	{0xD638, 0xD638, LV, conjunctNone},
+	{0xD639, 0xD653, LVT, conjunctNone},
+	{0xD654, 0xD654, LV, conjunctNone},
+	{0xD655, 0xD66F, LVT, conjunctNone},
+	{0xD670, 0xD670, LV, conjunctNone},
+	{0xD671, 0xD68B, LVT, conjunctNone},
+	{0xD68C, 0xD68C, LV, conjunctNone},
+	{0xD68D, 0xD6A7, LVT, conjunctNone},
+	{0xD6A8, 0xD6A8, LV, conjunctNone},
+	{0xD6A9, 0xD6C3, LVT, conjunctNone},
+	{0xD6C4, 0xD6C4, LV, conjunctNone},
+	{0xD6C5, 0xD6DF, LVT, conjunctNone},
+	{0xD6E0, 0xD6E0, LV, conjunctNone},
+	{0xD6E1, 0xD6FB, LVT, conjunctNone},
+	{0xD6FC, 0xD6FC, LV, conjunctNone},
+	{0xD6FD, 0xD717, LVT, conjunctNone},
+	{0xD718, 0xD718, LV, conjunctNone},
+	{0xD719, 0xD733, LVT, conjunctNone},
+	{0xD734, 0xD734, LV, conjunctNone},
+	{0xD735, 0xD74F, LVT, conjunctNone},
+	{0xD750, 0xD750, LV, conjunctNone},
+	{0xD751, 0xD76B, LVT, conjunctNone},
+	{0xD76C, 0xD76C, LV, conjunctNone},
+	{0xD76D, 0xD787, LVT, conjunctNone},
+	{0xD788, 0xD788, LV, conjunctNone},
+	{0xD789, 0xD7A3, LVT, conjunctNone},
+	{0xD7B0, 0xD7C6, V, conjunctNone},
+	{0xD7CB, 0xD7FB, T, conjunctNone},
+	{0xFB1E, 0xFB1E, Extend, conjunctExtend},
+	{0xFE00, 0xFE0F, Extend, conjunctExtend},
+	{0xFE20, 0xFE2F, Extend, conjunctExtend},
+	{0xFEFF, 0xFEFF, Control, conjunctNone},
+	{0xFF9E, 0xFF9F, Extend, conjunctExtend},
+	{0xFFF0, 0xFFFB, Control, conjunctNone},
+	{0x101FD, 0x101FD, Extend, conjunctExtend},
+	{0x102E0, 0x102E0, Extend, conjunctExtend},
+	{0x10376, 0x1037A, Extend, conjunctExtend},
+	{0x10A00, 0x10A00, Other, conjunctConsonant},
+	{0x10A01, 0x10A03, Extend, conjunctExtend},
+	{0x10A05, 0x10A06, Extend, conjunctExtend},
+	{0x10A0C, 0x10A0F, Extend, conjunctExtend},
+	{0x10A10, 0x10A13, Other, conjunctConsonant},
+	{0x10A15, 0x10A17, Other, conjunctConsonant},
+	{0x10A19, 0x10A35, Other, conjunctConsonant},
+	{0x10A38, 0x10A3A, Extend, conjunctExtend},
+	{0x10A3F, 0x10A3F, Extend, conjunctLinker},
+	{0x10AE5, 0x10AE6, Extend, conjunctExtend},
+	{0x10D24, 0x10D27, Extend, conjunctExtend},
+	{0x10D69, 0x10D6D, Extend, conjunctExtend},
+	{0x10EAB, 0x10EAC, Extend, conjunctExtend},
+	{0x10EFA, 0x10EFF, Extend, conjunctExtend},
+	{0x10F46, 0x10F50, Extend, conjunctExtend},
+	{0x10F82, 0x10F85, Extend, conjunctExtend},
+	{0x11000, 0x11000, SpacingMark, conjunctNone},
+	{0x11001, 0x11001, Extend, conjunctExtend},
+	{0x11002, 0x11002, SpacingMark, conjunctNone},
+	{0x11038, 0x11046, Extend, conjunctExtend},
+	{0x11070, 0x11070, Extend, conjunctExtend},
+	{0x11073, 0x11074, Extend, conjunctExtend},
+	{0x1107F, 0x11081, Extend, conjunctExtend},
+	{0x11082, 0x11082, SpacingMark, conjunctNone},
+	{0x110B0, 0x110B2, SpacingMark, conjunctNone},
+	{0x110B3, 0x110B6, Extend, conjunctExtend},
+	{0x110B7, 0x110B8, SpacingMark, conjunctNone},
+	{0x110B9, 0x110BA, Extend, conjunctExtend},
+	{0x110BD, 0x110BD, Prepend, conjunctNone},
+	{0x110C2, 0x110C2, Extend, conjunctExtend},
+	{0x110CD, 0x110CD, Prepend, conjunctNone},
+	{0x11100, 0x11102, Extend, conjunctExtend},
+	{0x11103, 0x11126, Other, conjunctConsonant},
+	{0x11127, 0x1112B, Extend, conjunctExtend},
+	{0x1112C, 0x1112C, SpacingMark, conjunctNone},
+	{0x1112D, 0x11132, Extend, conjunctExtend},
+	{0x11133, 0x11133, Extend, conjunctLinker},
+	{0x11134, 0x11134, Extend, conjunctExtend},
+	{0x11144, 0x11144, Other, conjunctConsonant},
+	{0x11145, 0x11146, SpacingMark, conjunctNone},
+	{0x11147, 0x11147, Other, conjunctConsonant},
+	{0x11173, 0x11173, Extend, conjunctExtend},
+	{0x11180, 0x11181, Extend, conjunctExtend},
+	{0x11182, 0x11182, SpacingMark, conjunctNone},
+	{0x111B3, 0x111B5, SpacingMark, conjunctNone},
+	{0x111B6, 0x111BE, Extend, conjunctExtend},
+	{0x111BF, 0x111BF, SpacingMark, conjunctNone},
+	{0x111C0, 0x111C0, Extend, conjunctExtend},
+	{0x111C2, 0x111C3, Prepend, conjunctNone},
+	{0x111C9, 0x111CC, Extend, conjunctExtend},
+	{0x111CE, 0x111CE, SpacingMark, conjunctNone},
+	{0x111CF, 0x111CF, Extend, conjunctExtend},
+	{0x1122C, 0x1122E, SpacingMark, conjunctNone},
+	{0x1122F, 0x11231, Extend, conjunctExtend},
+	{0x11232, 0x11233, SpacingMark, conjunctNone},
+	{0x11234, 0x11237, Extend, conjunctExtend},
+	{0x1123E, 0x1123E, Extend, conjunctExtend},
+	{0x11241, 0x11241, Extend, conjunctExtend},
+	{0x112DF, 0x112DF, Extend, conjunctExtend},
+	{0x112E0, 0x112E2, SpacingMark, conjunctNone},
+	{0x112E3, 0x112EA, Extend, conjunctExtend},
+	{0x11300, 0x11301, Extend, conjunctExtend},
+	{0x11302, 0x11303, SpacingMark, conjunctNone},
+	{0x1133B, 0x1133C, Extend, conjunctExtend},
+	{0x1133E, 0x1133E, Extend, conjunctExtend},
+	{0x1133F, 0x1133F, SpacingMark, conjunctNone},
+	{0x11340, 0x11340, Extend, conjunctExtend},
+	{0x11341, 0x11344, SpacingMark, conjunctNone},
+	{0x11347, 0x11348, SpacingMark, conjunctNone},
+	{0x1134B, 0x1134C, SpacingMark, conjunctNone},
+	{0x1134D, 0x1134D, Extend, conjunctExtend},
+	{0x11357, 0x11357, Extend, conjunctExtend},
+	{0x11362, 0x11363, SpacingMark, conjunctNone},
+	{0x11366, 0x1136C, Extend, conjunctExtend},
+	{0x11370, 0x11374, Extend, conjunctExtend},
+	{0x11380, 0x11389, Other, conjunctConsonant},
+	{0x1138B, 0x1138B, Other, conjunctConsonant},
+	{0x1138E, 0x1138E, Other, conjunctConsonant},
+	{0x11390, 0x113B5, Other, conjunctConsonant},
+	{0x113B8, 0x113B8, Extend, conjunctExtend},
+	{0x113B9, 0x113BA, SpacingMark, conjunctNone},
+	{0x113BB, 0x113C0, Extend, conjunctExtend},
+	{0x113C2, 0x113C2, Extend, conjunctExtend},
+	{0x113C5, 0x113C5, Extend, conjunctExtend},
+	{0x113C7, 0x113C9, Extend, conjunctExtend},
+	{0x113CA, 0x113CA, SpacingMark, conjunctNone},
+	{0x113CC, 0x113CD, SpacingMark, conjunctNone},
+	{0x113CE, 0x113CF, Extend, conjunctExtend},
+	{0x113D0, 0x113D0, Extend, conjunctLinker},
+	{0x113D1, 0x113D1, Prepend, conjunctNone},
+	{0x113D2, 0x113D2, Extend, conjunctExtend},
+	{0x113E1, 0x113E2, Extend, conjunctExtend},
+	{0x11435, 0x11437, SpacingMark, conjunctNone},
+	{0x11438, 0x1143F, Extend, conjunctExtend},
+	{0x11440, 0x11441, SpacingMark, conjunctNone},
+	{0x11442, 0x11444, Extend, conjunctExtend},
+	{0x11445, 0x11445, SpacingMark, conjunctNone},
+	{0x11446, 0x11446, Extend, conjunctExtend},
+	{0x1145E, 0x1145E, Extend, conjunctExtend},
+	{0x114B0, 0x114B0, Extend, conjunctExtend},
+	{0x114B1, 0x114B2, SpacingMark, conjunctNone},
+	{0x114B3, 0x114B8, Extend, conjunctExtend},
+	{0x114B9, 0x114B9, SpacingMark, conjunctNone},
+	{0x114BA, 0x114BA, Extend, conjunctExtend},
+	{0x114BB, 0x114BC, SpacingMark, conjunctNone},
+	{0x114BD, 0x114BD, Extend, conjunctExtend},
+	{0x114BE, 0x114BE, SpacingMark, conjunctNone},
+	{0x114BF, 0x114C0, Extend, conjunctExtend},
+	{0x114C1, 0x114C1, SpacingMark, conjunctNone},
+	{0x114C2, 0x114C3, Extend, conjunctExtend},
+	{0x115AF, 0x115AF, Extend, conjunctExtend},
+	{0x115B0, 0x115B1, SpacingMark, conjunctNone},
+	{0x115B2, 0x115B5, Extend, conjunctExtend},
+	{0x115B8, 0x115BB, SpacingMark, conjunctNone},
+	{0x115BC, 0x115BD, Extend, conjunctExtend},
+	{0x115BE, 0x115BE, SpacingMark, conjunctNone},
+	{0x115BF, 0x115C0, Extend, conjunctExtend},
+	{0x115DC, 0x115DD, Extend, conjunctExtend},
+	{0x11630, 0x11632, SpacingMark, conjunctNone},
+	{0x11633, 0x1163A, Extend, conjunctExtend},
+	{0x1163B, 0x1163C, SpacingMark, conjunctNone},
+	{0x1163D, 0x1163D, Extend, conjunctExtend},
+	{0x1163E, 0x1163E, SpacingMark, conjunctNone},
+	{0x1163F, 0x11640, Extend, conjunctExtend},
+	{0x116AB, 0x116AB, Extend, conjunctExtend},
+	{0x116AC, 0x116AC, SpacingMark, conjunctNone},
+	{0x116AD, 0x116AD, Extend, conjunctExtend},
+	{0x116AE, 0x116AF, SpacingMark, conjunctNone},
+	{0x116B0, 0x116B7, Extend, conjunctExtend},
+	{0x1171D, 0x1171D, Extend, conjunctExtend},
+	{0x1171E, 0x1171E, SpacingMark, conjunctNone},
+	{0x1171F, 0x1171F, Extend, conjunctExtend},
+	{0x11722, 0x11725, Extend, conjunctExtend},
+	{0x11726, 0x11726, SpacingMark, conjunctNone},
+	{0x11727, 0x1172B, Extend, conjunctExtend},
+	{0x1182C, 0x1182E, SpacingMark, conjunctNone},
+	{0x1182F, 0x11837, Extend, conjunctExtend},
+	{0x11838, 0x11838, SpacingMark, conjunctNone},
+	{0x11839, 0x1183A, Extend, conjunctExtend},
+	{0x11900, 0x11906, Other, conjunctConsonant},
+	{0x11909, 0x11909, Other, conjunctConsonant},
+	{0x1190C, 0x11913, Other, conjunctConsonant},
+	{0x11915, 0x11916, Other, conjunctConsonant},
+	{0x11918, 0x1192F, Other, conjunctConsonant},
+	{0x11930, 0x11930, Extend, conjunctExtend},
+	{0x11931, 0x11935, SpacingMark, conjunctNone},
+	{0x11937, 0x11938, SpacingMark, conjunctNone},
+	{0x1193B, 0x1193D, Extend, conjunctExtend},
+	{0x1193E, 0x1193E, Extend, conjunctLinker},
+	{0x1193F, 0x1193F, Prepend, conjunctNone},
+	{0x11940, 0x11940, SpacingMark, conjunctNone},
+	{0x11941, 0x11941, Prepend, conjunctNone},
+	{0x11942, 0x11942, SpacingMark, conjunctNone},
+	{0x11943, 0x11943, Extend, conjunctExtend},
+	{0x119D1, 0x119D3, SpacingMark, conjunctNone},
+	{0x119D4, 0x119D7, Extend, conjunctExtend},
+	{0x119DA, 0x119DB, Extend, conjunctExtend},
+	{0x119DC, 0x119DF, SpacingMark, conjunctNone},
+	{0x119E0, 0x119E0, Extend, conjunctExtend},
+	{0x119E4, 0x119E4, SpacingMark, conjunctNone},
+	{0x11A00, 0x11A00, Other, conjunctConsonant},
+	{0x11A01, 0x11A0A, Extend, conjunctExtend},
+	{0x11A0B, 0x11A32, Other, conjunctConsonant},
+	{0x11A33, 0x11A38, Extend, conjunctExtend},
+	{0x11A39, 0x11A39, SpacingMark, conjunctNone},
+	{0x11A3B, 0x11A3E, Extend, conjunctExtend},
+	{0x11A47, 0x11A47, Extend, conjunctLinker},
+	{0x11A50, 0x11A50, Other, conjunctConsonant},
+	{0x11A51, 0x11A56, Extend, conjunctExtend},
+	{0x11A57, 0x11A58, SpacingMark, conjunctNone},
+	{0x11A59, 0x11A5B, Extend, conjunctExtend},
+	{0x11A5C, 0x11A83, Other, conjunctConsonant},
+	{0x11A84, 0x11A89, Prepend, conjunctNone},
+	{0x11A8A, 0x11A96, Extend, conjunctExtend},
+	{0x11A97, 0x11A97, SpacingMark, conjunctNone},
+	{0x11A98, 0x11A98, Extend, conjunctExtend},
+	{0x11A99, 0x11A99, Extend, conjunctLinker},
+	{0x11B60, 0x11B60, Extend, conjunctExtend},
+	{0x11B61, 0x11B61, SpacingMark, conjunctNone},
+	{0x11B62, 0x11B64, Extend, conjunctExtend},
+	{0x11B65, 0x11B65, SpacingMark, conjunctNone},
+	{0x11B66, 0x11B66, Extend, conjunctExtend},
+	{0x11B67, 0x11B67, SpacingMark, conjunctNone},
+	{0x11C2F, 0x11C2F, SpacingMark, conjunctNone},
+	{0x11C30, 0x11C36, Extend, conjunctExtend},
+	{0x11C38, 0x11C3D, Extend, conjunctExtend},
+	{0x11C3E, 0x11C3E, SpacingMark, conjunctNone},
+	{0x11C3F, 0x11C3F, Extend, conjunctExtend},
+	{0x11C92, 0x11CA7, Extend, conjunctExtend},
+	{0x11CA9, 0x11CA9, SpacingMark, conjunctNone},
+	{0x11CAA, 0x11CB0, Extend, conjunctExtend},
+	{0x11CB1, 0x11CB1, SpacingMark, conjunctNone},
+	{0x11CB2, 0x11CB3, Extend, conjunctExtend},
+	{0x11CB4, 0x11CB4, SpacingMark, conjunctNone},
+	{0x11CB5, 0x11CB6, Extend, conjunctExtend},
+	{0x11D31, 0x11D36, Extend, conjunctExtend},
+	{0x11D3A, 0x11D3A, Extend, conjunctExtend},
+	{0x11D3C, 0x11D3D, Extend, conjunctExtend},
+	{0x11D3F, 0x11D45, Extend, conjunctExtend},
+	{0x11D46, 0x11D46, Prepend, conjunctNone},
+	{0x11D47, 0x11D47, Extend, conjunctExtend},
+	{0x11D8A, 0x11D8E, SpacingMark, conjunctNone},
+	{0x11D90, 0x11D91, Extend, conjunctExtend},
+	{0x11D93, 0x11D94, SpacingMark, conjunctNone},
+	{0x11D95, 0x11D95, Extend, conjunctExtend},
+	{0x11D96, 0x11D96, SpacingMark, conjunctNone},
+	{0x11D97, 0x11D97, Extend, conjunctExtend},
+	{0x11EF3, 0x11EF4, Extend, conjunctExtend},
+	{0x11EF5, 0x11EF6, SpacingMark, conjunctNone},
+	{0x11F00, 0x11F01, Extend, conjunctExtend},
+	{0x11F02, 0x11F02, Prepend, conjunctNone},
+	{0x11F03, 0x11F03, SpacingMark, conjunctNone},
+	{0x11F04, 0x11F10, Other, conjunctConsonant},
+	{0x11F12, 0x11F33, Other, conjunctConsonant},
+	{0x11F34, 0x11F35, SpacingMark, conjunctNone},
+	{0x11F36, 0x11F3A, Extend, conjunctExtend},
+	{0x11F3E, 0x11F3F, SpacingMark, conjunctNone},
+	{0x11F40, 0x11F41, Extend, conjunctExtend},
+	{0x11F42, 0x11F42, Extend, conjunctLinker},
+	{0x11F5A, 0x11F5A, Extend, conjunctExtend},
+	{0x13430, 0x1343F, Control, conjunctNone},
+	{0x13440, 0x13440, Extend, conjunctExtend},
+	{0x13447, 0x13455, Extend, conjunctExtend},
+	{0x1611E, 0x16129, Extend, conjunctExtend},
+	{0x1612A, 0x1612C, SpacingMark, conjunctNone},
+	{0x1612D, 0x1612F, Extend, conjunctExtend},
+	{0x16AF0, 0x16AF4, Extend, conjunctExtend},
+	{0x16B30, 0x16B36, Extend, conjunctExtend},
+	{0x16D63, 0x16D63, V, conjunctNone},
+	{0x16D67, 0x16D6A, V, conjunctNone},
+	{0x16F4F, 0x16F4F, Extend, conjunctExtend},
+	{0x16F51, 0x16F87, SpacingMark, conjunctNone},
+	{0x16F8F, 0x16F92, Extend, conjunctExtend},
+	{0x16FE4, 0x16FE4, Extend, conjunctExtend},
+	{0x16FF0, 0x16FF1, Extend, conjunctExtend},
+	{0x1BC9D, 0x1BC9E, Extend, conjunctExtend},
+	{0x1BCA0, 0x1BCA3, Control, conjunctNone},
+	{0x1CF00, 0x1CF2D, Extend, conjunctExtend},
+	{0x1CF30, 0x1CF46, Extend, conjunctExtend},
+	{0x1D165, 0x1D169, Extend, conjunctExtend},
+	{0x1D16D, 0x1D172, Extend, conjunctExtend},
+	{0x1D173, 0x1D17A, Control, conjunctNone},
+	{0x1D17B, 0x1D182, Extend, conjunctExtend},
+	{0x1D185, 0x1D18B, Extend, conjunctExtend},
+	{0x1D1AA, 0x1D1AD, Extend, conjunctExtend},
+	{0x1D242, 0x1D244, Extend, conjunctExtend},
+	{0x1DA00, 0x1DA36, Extend, conjunctExtend},
+	{0x1DA3B, 0x1DA6C, Extend, conjunctExtend},
+	{0x1DA75, 0x1DA75, Extend, conjunctExtend},
+	{0x1DA84, 0x1DA84, Extend, conjunctExtend},
+	{0x1DA9B, 0x1DA9F, Extend, conjunctExtend},
+	{0x1DAA1, 0x1DAAF, Extend, conjunctExtend},
+	{0x1E000, 0x1E006, Extend, conjunctExtend},
+	{0x1E008, 0x1E018, Extend, conjunctExtend},
+	{0x1E01B, 0x1E021, Extend, conjunctExtend},
+	{0x1E023, 0x1E024, Extend, conjunctExtend},
+	{0x1E026, 0x1E02A, Extend, conjunctExtend},
+	{0x1E08F, 0x1E08F, Extend, conjunctExtend},
+	{0x1E130, 0x1E136, Extend, conjunctExtend},
+	{0x1E2AE, 0x1E2AE, Extend, conjunctExtend},
+	{0x1E2EC, 0x1E2EF, Extend, conjunctExtend},
+	{0x1E4EC, 0x1E4EF, Extend, conjunctExtend},
+	{0x1E5EE, 0x1E5EF, Extend, conjunctExtend},
+	{0x1E6E3, 0x1E6E3, Extend, conjunctExtend},
+	{0x1E6E6, 0x1E6E6, Extend, conjunctExtend},
+	{0x1E6EE, 0x1E6EF, Extend, conjunctExtend},
+	{0x1E6F5, 0x1E6F5, Extend, conjunctExtend},
+	{0x1E8D0, 0x1E8D6, Extend, conjunctExtend},
+	{0x1E944, 0x1E94A, Extend, conjunctExtend},
+	{0x1F004, 0x1F004, ExtendedPictographic, conjunctNone},
+	{0x1F02C, 0x1F02F, ExtendedPictographic, conjunctNone},
+	{0x1F094, 0x1F09F, ExtendedPictographic, conjunctNone},
+	{0x1F0AF, 0x1F0B0, ExtendedPictographic, conjunctNone},
+	{0x1F0C0, 0x1F0C0, ExtendedPictographic, conjunctNone},
+	{0x1F0CF, 0x1F0D0, ExtendedPictographic, conjunctNone},
+	{0x1F0F6, 0x1F0FF, ExtendedPictographic, conjunctNone},
+	{0x1F170, 0x1F171, ExtendedPictographic, conjunctNone},
+	{0x1F17E, 0x1F17F, ExtendedPictographic, conjunctNone},
+	{0x1F18E, 0x1F18E, ExtendedPictographic, conjunctNone},
+	{0x1F191, 0x1F19A, ExtendedPictographic, conjunctNone},
+	{0x1F1AE, 0x1F1E5, ExtendedPictographic, conjunctNone},
+	{0x1F1E6, 0x1F1FF, RegionalIndicator, conjunctNone},
+	{0x1F201, 0x1F20F, ExtendedPictographic, conjunctNone},
+	{0x1F21A, 0x1F21A, ExtendedPictographic, conjunctNone},
+	{0x1F22F, 0x1F22F, ExtendedPictographic, conjunctNone},
+	{0x1F232, 0x1F23A, ExtendedPictographic, conjunctNone},
+	{0x1F23C, 0x1F23F, ExtendedPictographic, conjunctNone},
+	{0x1F249, 0x1F25F, ExtendedPictographic, conjunctNone},
+	{0x1F266, 0x1F321, ExtendedPictographic, conjunctNone},
+	{0x1F324, 0x1F393, ExtendedPictographic, conjunctNone},
+	{0x1F396, 0x1F397, ExtendedPictographic, conjunctNone},
+	{0x1F399, 0x1F39B, ExtendedPictographic, conjunctNone},
+	{0x1F39E, 0x1F3F0, ExtendedPictographic, conjunctNone},
+	{0x1F3F3, 0x1F3F5, ExtendedPictographic, conjunctNone},
+	{0x1F3F7, 0x1F3FA, ExtendedPictographic, conjunctNone},
+	{0x1F3FB, 0x1F3FF, Extend, conjunctExtend},
+	{0x1F400, 0x1F4FD, ExtendedPictographic, conjunctNone},
+	{0x1F4FF, 0x1F53D, ExtendedPictographic, conjunctNone},
+	{0x1F549, 0x1F54E, ExtendedPictographic, conjunctNone},
+	{0x1F550, 0x1F567, ExtendedPictographic, conjunctNone},
+	{0x1F56F, 0x1F570, ExtendedPictographic, conjunctNone},
+	{0x1F573, 0x1F57A, ExtendedPictographic, conjunctNone},
+	{0x1F587, 0x1F587, ExtendedPictographic, conjunctNone},
+	{0x1F58A, 0x1F58D, ExtendedPictographic, conjunctNone},
+	{0x1F590, 0x1F590, ExtendedPictographic, conjunctNone},
+	{0x1F595, 0x1F596, ExtendedPictographic, conjunctNone},
+	{0x1F5A4, 0x1F5A5, ExtendedPictographic, conjunctNone},
+	{0x1F5A8, 0x1F5A8, ExtendedPictographic, conjunctNone},
+	{0x1F5B1, 0x1F5B2, ExtendedPictographic, conjunctNone},
+	{0x1F5BC, 0x1F5BC, ExtendedPictographic, conjunctNone},
+	{0x1F5C2, 0x1F5C4, ExtendedPictographic, conjunctNone},
+	{0x1F5D1, 0x1F5D3, ExtendedPictographic, conjunctNone},
+	{0x1F5DC, 0x1F5DE, ExtendedPictographic, conjunctNone},
+	{0x1F5E1, 0x1F5E1, ExtendedPictographic, conjunctNone},
+	{0x1F5E3, 0x1F5E3, ExtendedPictographic, conjunctNone},
+	{0x1F5E8, 0x1F5E8, ExtendedPictographic, conjunctNone},
+	{0x1F5EF, 0x1F5EF, ExtendedPictographic, conjunctNone},
+	{0x1F5F3, 0x1F5F3, ExtendedPictographic, conjunctNone},
+	{0x1F5FA, 0x1F64F, ExtendedPictographic, conjunctNone},
+	{0x1F680, 0x1F6C5, ExtendedPictographic, conjunctNone},
+	{0x1F6CB, 0x1F6D2, ExtendedPictographic, conjunctNone},
+	{0x1F6D5, 0x1F6E5, ExtendedPictographic, conjunctNone},
+	{0x1F6E9, 0x1F6E9, ExtendedPictographic, conjunctNone},
+	{0x1F6EB, 0x1F6F0, ExtendedPictographic, conjunctNone},
+	{0x1F6F3, 0x1F6FF, ExtendedPictographic, conjunctNone},
+	{0x1F7DA, 0x1F7FF, ExtendedPictographic, conjunctNone},
+	{0x1F80C, 0x1F80F, ExtendedPictographic, conjunctNone},
+	{0x1F848, 0x1F84F, ExtendedPictographic, conjunctNone},
+	{0x1F85A, 0x1F85F, ExtendedPictographic, conjunctNone},
+	{0x1F888, 0x1F88F, ExtendedPictographic, conjunctNone},
+	{0x1F8AE, 0x1F8AF, ExtendedPictographic, conjunctNone},
+	{0x1F8BC, 0x1F8BF, ExtendedPictographic, conjunctNone},
+	{0x1F8C2, 0x1F8CF, ExtendedPictographic, conjunctNone},
+	{0x1F8D9, 0x1F8FF, ExtendedPictographic, conjunctNone},
+	{0x1F90C, 0x1F93A, ExtendedPictographic, conjunctNone},
+	{0x1F93C, 0x1F945, ExtendedPictographic, conjunctNone},
+	{0x1F947, 0x1F9FF, ExtendedPictographic, conjunctNone},
+	{0x1FA58, 0x1FA5F, ExtendedPictographic, conjunctNone},
+	{0x1FA6E, 0x1FAFF, ExtendedPictographic, conjunctNone},
+	{0x1FC00, 0x1FFFD, ExtendedPictographic, conjunctNone},
+	{0xE0000, 0xE001F, Control, conjunctNone},
+	{0xE0020, 0xE007F, Extend, conjunctExtend},
+	{0xE0080, 0xE00FF, Control, conjunctNone},
+	{0xE0100, 0xE01EF, Extend, conjunctExtend},
+	{0xE01F0, 0xE0FFF, Control, conjunctNone},
+}
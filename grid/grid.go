@@ -0,0 +1,110 @@
+// Package grid holds a rectangular map of text, like the ones puzzles have you walk across, where every cell is a
+// grapheme cluster rather than a byte or a code point. That way an emoji with a skin tone or a variation selector, or
+// a letter with a combining accent, is still one cell
+package grid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lthummus/i18n-puzzles/grapheme"
+)
+
+// Point is the position of a cell, with X counting across from the left and Y down from the top, both starting at 0
+type Point struct {
+	X int
+	Y int
+}
+
+// RaggedError is returned when the rows of a grid aren't all the same number of cells wide
+type RaggedError struct {
+	// Row is the index of the first row that's the wrong width
+	Row int
+
+	Cells int
+	Want  int
+}
+
+func (e *RaggedError) Error() string {
+	return fmt.Sprintf("grid: row %d is %d cells wide, expected %d", e.Row, e.Cells, e.Want)
+}
+
+// Grid is a rectangle of cells. Positions outside of it wrap around, so it repeats forever in every direction
+type Grid struct {
+	cells  [][]string
+	width  int
+	height int
+}
+
+// Parse makes a grid from its rows, splitting each one in to grapheme clusters. Every row has to be the same number
+// of clusters wide, and there has to be at least one cell
+func Parse(rows []string) (*Grid, error) {
+	if len(rows) == 0 || rows[0] == "" {
+		return nil, fmt.Errorf("grid: Parse: no cells")
+	}
+
+	g := &Grid{
+		cells:  make([][]string, len(rows)),
+		height: len(rows),
+	}
+
+	for i, row := range rows {
+		g.cells[i] = grapheme.Split(row)
+		if i == 0 {
+			g.width = len(g.cells[i])
+		}
+
+		if len(g.cells[i]) != g.width {
+			return nil, &RaggedError{Row: i, Cells: len(g.cells[i]), Want: g.width}
+		}
+	}
+
+	return g, nil
+}
+
+// Width is how many cells wide the grid is
+func (g *Grid) Width() int {
+	return g.width
+}
+
+// Height is how many rows the grid has
+func (g *Grid) Height() int {
+	return g.height
+}
+
+// At is the cell at a position, wrapping around the edges if it's outside of the grid
+func (g *Grid) At(p Point) string {
+	p = g.Wrap(p)
+	return g.cells[p.Y][p.X]
+}
+
+// Wrap moves a position that's outside the grid to the cell it refers to inside of it
+func (g *Grid) Wrap(p Point) Point {
+	return Point{X: mod(p.X, g.width), Y: mod(p.Y, g.height)}
+}
+
+// Row is the cells in one row. It mustn't be modified
+func (g *Grid) Row(y int) []string {
+	return g.cells[mod(y, g.height)]
+}
+
+// String is the grid with its rows on separate lines
+func (g *Grid) String() string {
+	var sb strings.Builder
+	for _, row := range g.cells {
+		for _, cell := range row {
+			sb.WriteString(cell)
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// mod is x mod n, but always between 0 and n-1, even for negative x
+func mod(x int, n int) int {
+	m := x % n
+	if m < 0 {
+		m += n
+	}
+	return m
+}
@@ -0,0 +1,128 @@
+package grid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	g, err := Parse([]string{
+		"a💩🏽b",
+		"c💩️d",
+		"éfg",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, g.Width())
+	assert.Equal(t, 3, g.Height())
+	assert.Equal(t, "💩🏽", g.At(Point{X: 1, Y: 0}))
+	assert.Equal(t, "é", g.At(Point{X: 0, Y: 2}))
+	assert.Equal(t, "a💩🏽b\nc💩️d\néfg\n", g.String())
+
+	t.Run("wraps", func(t *testing.T) {
+		assert.Equal(t, "a", g.At(Point{X: 3, Y: 3}))
+		assert.Equal(t, "g", g.At(Point{X: -1, Y: -1}))
+		assert.Equal(t, Point{X: 2, Y: 1}, g.Wrap(Point{X: -4, Y: 7}))
+	})
+
+	t.Run("ragged", func(t *testing.T) {
+		_, err := Parse([]string{"abc", "abc", "ab"})
+		var ragged *RaggedError
+		require.ErrorAs(t, err, &ragged)
+		assert.Equal(t, RaggedError{Row: 2, Cells: 2, Want: 3}, *ragged)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := Parse(nil)
+		assert.Error(t, err)
+		_, err = Parse([]string{""})
+		assert.Error(t, err)
+	})
+}
+
+func Test_CellWidth(t *testing.T) {
+	tests := []struct {
+		cell string
+		want int
+	}{
+		{cell: "a", want: 1},
+		{cell: "é", want: 1},
+		{cell: "日", want: 2},
+		{cell: "Ａ", want: 2},
+		{cell: "ｱ", want: 1},
+		{cell: "💩", want: 2},
+		{cell: "💩🏽", want: 2},
+		{cell: "☺", want: 1},
+		{cell: "☺️", want: 2},
+		{cell: "🇯🇵", want: 2},
+		{cell: "\t", want: 0},
+		{cell: "", want: 0},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, CellWidth(tc.cell), "%+q", tc.cell)
+	}
+
+	g, err := Parse([]string{"a日b💩c"})
+	require.NoError(t, err)
+	assert.Equal(t, 7, g.RowWidth(0))
+	assert.Equal(t, 4, g.Column(Point{X: 3, Y: 0}))
+}
+
+func Test_Walk(t *testing.T) {
+	g, err := Parse([]string{
+		"x.x.",
+		".x.x",
+		"xx..",
+	})
+	require.NoError(t, err)
+
+	var points []Point
+	for p, cell := range g.Walk(Slope{Right: 3, Down: 1}, 5) {
+		assert.Equal(t, g.At(p), cell)
+		points = append(points, p)
+	}
+	assert.Equal(t, []Point{{0, 0}, {3, 1}, {2, 2}, {1, 0}, {0, 1}}, points)
+
+	t.Run("backwards", func(t *testing.T) {
+		points = nil
+		for p := range g.Walk(Slope{Right: -1, Down: -1}, 3) {
+			points = append(points, p)
+		}
+		assert.Equal(t, []Point{{0, 0}, {3, 2}, {2, 1}}, points)
+	})
+
+	t.Run("descent", func(t *testing.T) {
+		assert.Equal(t, 3, g.Descent(Slope{Right: 1, Down: 1}))
+		assert.Equal(t, 2, g.Descent(Slope{Right: 1, Down: 2}))
+		assert.Equal(t, 1, g.Descent(Slope{Right: 1, Down: 3}))
+		assert.Equal(t, 2, g.Descent(Slope{Right: 1, Down: -2}))
+		assert.Equal(t, 4, g.Descent(Slope{Right: 1, Down: 0}))
+		assert.Equal(t, 2, g.Descent(Slope{Right: 2, Down: 0}))
+		assert.Equal(t, 1, g.Descent(Slope{}))
+	})
+
+	t.Run("count", func(t *testing.T) {
+		x := Is("x")
+		assert.Equal(t, 2, g.Count(Slope{Right: 1, Down: 1}, ToBottom, x))
+		assert.Equal(t, 3, g.Count(Slope{Right: 1, Down: 1}, 6, x))
+		assert.Equal(t, 2, g.Count(Slope{Right: 2, Down: 0}, ToBottom, x))
+
+		slopes := []Slope{{Right: 1, Down: 1}, {Right: 1, Down: 2}, {Right: 1, Down: 0}, {Right: 3, Down: 1}}
+		assert.Equal(t, []int{2, 2, 2, 2}, g.CountSlopes(slopes, ToBottom, x))
+		assert.Equal(t, []int{1, 1, 1, 1}, g.CountSlopes(slopes, 1, x))
+		assert.Equal(t, []int{3, 4, 3, 2}, g.CountSlopes(slopes, 6, x))
+		for i, s := range slopes {
+			assert.Equal(t, g.Count(s, 5, x), g.CountSlopes(slopes, 5, x)[i])
+		}
+	})
+}
+
+func Test_StartsWith(t *testing.T) {
+	g, err := Parse([]string{"💩💩🏽💩️a"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, g.Count(Slope{Right: 1}, ToBottom, StartsWith('💩')))
+	assert.Equal(t, 1, g.Count(Slope{Right: 1}, ToBottom, Is("💩")))
+}
@@ -0,0 +1,121 @@
+package grid
+
+import (
+	"iter"
+	"strings"
+)
+
+// Slope is how far a walk across a grid moves with each step. Either can be negative, to go left or up
+type Slope struct {
+	Right int
+	Down  int
+}
+
+// ToBottom can be given as the number of steps for a walk to take its Descent
+const ToBottom = -1
+
+// Walk visits steps cells (or ToBottom), starting from the top left and moving along the slope, wrapping around
+// whenever it goes off an edge
+func (g *Grid) Walk(s Slope, steps int) iter.Seq2[Point, string] {
+	if steps == ToBottom {
+		steps = g.Descent(s)
+	}
+
+	return func(yield func(Point, string) bool) {
+		p := Point{}
+		for range steps {
+			if !yield(p, g.cells[p.Y][p.X]) {
+				return
+			}
+			p = g.Wrap(Point{X: p.X + s.Right, Y: p.Y + s.Down})
+		}
+	}
+}
+
+// Descent is how many steps along a slope it takes to get from the top row to the bottom one without wrapping back
+// around to the top, so every row the slope lands on is visited once. A slope that doesn't go down goes along its row
+// until it gets back to where it started instead
+func (g *Grid) Descent(s Slope) int {
+	if s.Down == 0 {
+		return g.width / gcd(abs(s.Right), g.width)
+	}
+
+	return (g.height-1)/abs(s.Down) + 1
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func gcd(a int, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// Matcher decides which cells a walk is counting
+type Matcher func(cell string) bool
+
+// Is matches cells that are exactly the given grapheme cluster
+func Is(cell string) Matcher {
+	return func(x string) bool {
+		return x == cell
+	}
+}
+
+// StartsWith matches cells whose first character is r, whatever follows it, so StartsWith('💩') also matches 💩 with a
+// skin tone or variation selector
+func StartsWith(r rune) Matcher {
+	prefix := string(r)
+	return func(x string) bool {
+		return strings.HasPrefix(x, prefix)
+	}
+}
+
+// Count counts the cells a walk lands on that match
+func (g *Grid) Count(s Slope, steps int, match Matcher) int {
+	count := 0
+	for _, cell := range g.Walk(s, steps) {
+		if match(cell) {
+			count++
+		}
+	}
+	return count
+}
+
+// CountSlopes counts the matching cells along several slopes at once, each taking the same number of steps (or its
+// own Descent, for ToBottom). The counts are in the same order as the slopes
+func (g *Grid) CountSlopes(slopes []Slope, steps int, match Matcher) []int {
+	counts := make([]int, len(slopes))
+	points := make([]Point, len(slopes))
+
+	limits := make([]int, len(slopes))
+	longest := 0
+	for i, s := range slopes {
+		limits[i] = steps
+		if steps == ToBottom {
+			limits[i] = g.Descent(s)
+		}
+		longest = max(longest, limits[i])
+	}
+
+	for step := range longest {
+		for i, s := range slopes {
+			if step >= limits[i] {
+				continue
+			}
+
+			p := points[i]
+			if match(g.cells[p.Y][p.X]) {
+				counts[i]++
+			}
+			points[i] = g.Wrap(Point{X: p.X + s.Right, Y: p.Y + s.Down})
+		}
+	}
+
+	return counts
+}
@@ -0,0 +1,61 @@
+package grid
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+
+	"github.com/lthummus/i18n-puzzles/grapheme"
+)
+
+// CellWidth is how many columns a cell takes up in a terminal, using the East Asian Width property of its first
+// character: wide and fullwidth characters (CJK, and most emoji) take two, ambiguous ones are narrow like they are
+// outside of East Asia, and everything else takes one. A variation selector asking for emoji or text presentation
+// makes it two or one, flags are always two, and control characters take none
+func CellWidth(cell string) int {
+	switch {
+	case cell == "":
+		return 0
+	case strings.ContainsRune(cell, 0xFE0F):
+		return 2
+	case strings.ContainsRune(cell, 0xFE0E):
+		return 1
+	}
+
+	r, _ := utf8.DecodeRuneInString(cell)
+	switch grapheme.PropertyOf(r) {
+	case grapheme.Control, grapheme.CR, grapheme.LF:
+		return 0
+	case grapheme.RegionalIndicator:
+		return 2
+	}
+
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// RowWidth is how many columns a row takes up in a terminal
+func (g *Grid) RowWidth(y int) int {
+	total := 0
+	for _, cell := range g.Row(y) {
+		total += CellWidth(cell)
+	}
+	return total
+}
+
+// Column is the terminal column (starting at 0) that a cell starts in, which is further right than its X if there
+// are wide cells before it in the row
+func (g *Grid) Column(p Point) int {
+	p = g.Wrap(p)
+
+	col := 0
+	for _, cell := range g.cells[p.Y][:p.X] {
+		col += CellWidth(cell)
+	}
+	return col
+}
@@ -0,0 +1,82 @@
+package input
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input/inputtest"
+)
+
+func Test_ExpectedAnswers(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewClient(WithCacheDir(dir), WithSource(NewMemorySource()))
+	require.NoError(t, err)
+
+	t.Run("nothing recorded", func(t *testing.T) {
+		_, ok, err := c.ExpectedAnswer(3, RealInput)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("set and read back", func(t *testing.T) {
+		require.NoError(t, c.SetExpectedAnswer(3, TestInput, " 2\n"))
+		require.NoError(t, c.SetExpectedAnswer(3, RealInput, "509"))
+
+		ans, ok, err := c.ExpectedAnswer(3, TestInput)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "2", ans)
+
+		ans, ok, err = c.ExpectedAnswer(3, RealInput)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "509", ans)
+
+		raw, err := os.ReadFile(filepath.Join(dir, AnswersFileName))
+		require.NoError(t, err)
+		assert.Contains(t, string(raw), `"test": "2"`)
+	})
+
+	t.Run("falls back to correct submissions", func(t *testing.T) {
+		srv := inputtest.NewServer(t, "secret")
+		srv.SetAnswer(7, "42")
+
+		sc, err := NewClient(WithBaseURL(srv.URL), WithCacheDir(t.TempDir()), WithTokenProvider(StaticToken("secret")))
+		require.NoError(t, err)
+
+		_, err = sc.SubmitAnswer(context.Background(), 7, "41")
+		require.NoError(t, err)
+		_, ok, err := sc.ExpectedAnswer(7, RealInput)
+		assert.NoError(t, err)
+		assert.False(t, ok, "incorrect answers should not be expected")
+
+		_, err = sc.SubmitAnswer(context.Background(), 7, "42")
+		require.NoError(t, err)
+		ans, ok, err := sc.ExpectedAnswer(7, RealInput)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "42", ans)
+
+		_, ok, err = sc.ExpectedAnswer(7, TestInput)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func Test_ParseKind(t *testing.T) {
+	k, err := ParseKind("real")
+	assert.NoError(t, err)
+	assert.Equal(t, RealInput, k)
+
+	k, err = ParseKind("TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, TestInput, k)
+
+	_, err = ParseKind("both")
+	assert.Error(t, err)
+}
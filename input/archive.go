@@ -0,0 +1,521 @@
+package input
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ManifestFileName is the first file in an exported cache archive. It lists the checksum of every other file
+const ManifestFileName = "manifest.json"
+
+// maxArchiveFileSize is far bigger than any input, and is only there so a broken (or hostile) archive can't make us
+// read forever
+const maxArchiveFileSize = 64 << 20
+
+type manifestFile struct {
+	SHA256 string `json:"sha256"`
+	Size   int    `json:"size"`
+}
+
+type manifest struct {
+	CreatedAt time.Time               `json:"created_at"`
+	Files     map[string]manifestFile `json:"files"`
+}
+
+// archiveFileRegex matches everything that is allowed in an archive. Notably, the token file is not
+var archiveFileRegex = regexp.MustCompile(`^(?:(\d+)(-test)?\.(txt|meta\.json)|(\d+)\.puzzle\.(html|meta\.json)|` + regexp.QuoteMeta(AnswersFileName) + `|` + regexp.QuoteMeta(LedgerFileName) + `)$`)
+
+var pageFileRegex = regexp.MustCompile(`^(\d+)\.puzzle\.html$`)
+
+// ExportCache writes every cached input (with its metadata), every cached puzzle page, the expected answers and the
+// submission ledger to w as a gzipped tar file, for ImportCache to load on another machine. The session token is never
+// included. Cached inputs that fail verification are left out and returned, so the caller can say so
+func (c *Client) ExportCache(w io.Writer) ([]string, []CacheEntry, error) {
+	cache := c.Cache()
+
+	files := map[string][]byte{}
+	var skipped []CacheEntry
+
+	entries, err := cache.Entries()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, e := range entries {
+		data, meta, err := cache.read(e.Puzzle, e.Kind)
+		if err != nil {
+			skipped = append(skipped, e)
+			continue
+		}
+		if meta == nil {
+			// cached before we kept metadata, but read has made sure it isn't an error page, so describe it now
+			m := NewMetadata(data)
+			meta = &m
+		}
+
+		metaBytes, err := json.MarshalIndent(meta, "", "  ")
+		if err != nil {
+			return nil, nil, fmt.Errorf("downloader: ExportCache: could not encode metadata: %w", err)
+		}
+
+		files[filepath.Base(getInputFile(cache.Directory, e.Puzzle, e.Kind))] = data
+		files[filepath.Base(getMetadataFile(cache.Directory, e.Puzzle, e.Kind))] = metaBytes
+	}
+
+	dir, err := os.ReadDir(cache.Directory)
+	if err != nil {
+		return nil, nil, fmt.Errorf("downloader: ExportCache: could not list cache directory: %w", err)
+	}
+	for _, curr := range dir {
+		m := pageFileRegex.FindStringSubmatch(curr.Name())
+		if m == nil {
+			continue
+		}
+		num, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+
+		page, err := cache.Page(num)
+		if errors.Is(err, ErrNoInput) {
+			// a bad page is downloaded again whenever it's needed, so it isn't worth mentioning
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		metaBytes, err := os.ReadFile(getPageMetadataFile(cache.Directory, num))
+		if err != nil {
+			return nil, nil, fmt.Errorf("downloader: ExportCache: could not read page metadata: %w", err)
+		}
+
+		files[curr.Name()] = page
+		files[filepath.Base(getPageMetadataFile(cache.Directory, num))] = metaBytes
+	}
+
+	c.answersMu.Lock()
+	c.ledgerMu.Lock()
+	for _, name := range []string{AnswersFileName, LedgerFileName} {
+		b, err := os.ReadFile(filepath.Join(cache.Directory, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			c.ledgerMu.Unlock()
+			c.answersMu.Unlock()
+			return nil, nil, fmt.Errorf("downloader: ExportCache: could not read %s: %w", name, err)
+		}
+		files[name] = b
+	}
+	c.ledgerMu.Unlock()
+	c.answersMu.Unlock()
+
+	err = writeArchive(w, files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return slices.Sorted(maps.Keys(files)), skipped, nil
+}
+
+func writeArchive(w io.Writer, files map[string][]byte) error {
+	now := time.Now().UTC()
+
+	m := manifest{
+		CreatedAt: now,
+		Files:     map[string]manifestFile{},
+	}
+	for name, data := range files {
+		sum := sha256.Sum256(data)
+		m.Files[name] = manifestFile{SHA256: hex.EncodeToString(sum[:]), Size: len(data)}
+	}
+	names := slices.Sorted(maps.Keys(files))
+
+	manifestBytes, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("downloader: ExportCache: could not encode manifest: %w", err)
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	for _, name := range append([]string{ManifestFileName}, names...) {
+		data := manifestBytes
+		if name != ManifestFileName {
+			data = files[name]
+		}
+
+		err = tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Size:     int64(len(data)),
+			Mode:     0644,
+			ModTime:  now,
+		})
+		if err == nil {
+			_, err = tw.Write(data)
+		}
+		if err != nil {
+			return fmt.Errorf("downloader: ExportCache: could not write %s to archive: %w", name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("downloader: ExportCache: could not finish archive: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return fmt.Errorf("downloader: ExportCache: could not finish archive: %w", err)
+	}
+
+	return nil
+}
+
+// readArchive reads an archive written by ExportCache, making sure it only contains files we know about and that every
+// one of them matches the manifest
+func readArchive(r io.Reader) (map[string][]byte, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("downloader: ImportCache: not a gzipped archive: %w", err)
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+
+	files := map[string][]byte{}
+	var m *manifest
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("downloader: ImportCache: could not read archive: %w", err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("downloader: ImportCache: %s: only regular files are allowed in an archive", hdr.Name)
+		}
+		if hdr.Name != ManifestFileName && !archiveFileRegex.MatchString(hdr.Name) {
+			return nil, fmt.Errorf("downloader: ImportCache: %s: unexpected file in archive", hdr.Name)
+		}
+		if hdr.Size > maxArchiveFileSize {
+			return nil, fmt.Errorf("downloader: ImportCache: %s: file is too big (%d bytes)", hdr.Name, hdr.Size)
+		}
+		if _, ok := files[hdr.Name]; ok || (hdr.Name == ManifestFileName && m != nil) {
+			return nil, fmt.Errorf("downloader: ImportCache: %s: file appears more than once in archive", hdr.Name)
+		}
+
+		data, err := io.ReadAll(io.LimitReader(tr, maxArchiveFileSize))
+		if err != nil {
+			return nil, fmt.Errorf("downloader: ImportCache: could not read %s from archive: %w", hdr.Name, err)
+		}
+
+		if hdr.Name == ManifestFileName {
+			m = &manifest{}
+			if err := json.Unmarshal(data, m); err != nil {
+				return nil, fmt.Errorf("downloader: ImportCache: could not parse manifest: %w", err)
+			}
+			continue
+		}
+
+		files[hdr.Name] = data
+	}
+
+	if m == nil {
+		return nil, fmt.Errorf("downloader: ImportCache: archive has no %s", ManifestFileName)
+	}
+
+	for name, want := range m.Files {
+		data, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("downloader: ImportCache: %s is in the manifest but not the archive", name)
+		}
+
+		sum := sha256.Sum256(data)
+		if len(data) != want.Size || hex.EncodeToString(sum[:]) != want.SHA256 {
+			return nil, fmt.Errorf("downloader: ImportCache: %s: does not match its checksum in the manifest", name)
+		}
+	}
+	for name := range files {
+		if _, ok := m.Files[name]; !ok {
+			return nil, fmt.Errorf("downloader: ImportCache: %s is in the archive but not the manifest", name)
+		}
+	}
+
+	return files, nil
+}
+
+// ImportResult says what ImportCache did
+type ImportResult struct {
+	// Imported and Unchanged are the names of the inputs, pages, answers and ledger in the archive, depending on whether
+	// they were written to the cache or the cache already had exactly the same thing
+	Imported  []string
+	Unchanged []string
+}
+
+// ConflictError is returned by ImportCache when the archive disagrees with what's already in the cache
+type ConflictError struct {
+	Conflicts []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("downloader: ImportCache: archive conflicts with the existing cache: %s", strings.Join(e.Conflicts, "; "))
+}
+
+// importFile is a cached input or page from an archive, along with its metadata
+type importFile struct {
+	name     string
+	num      int
+	kind     Kind
+	page     bool
+	data     []byte
+	meta     Metadata
+	metaName string
+}
+
+// ImportCache loads an archive written by ExportCache in to this client's cache. Every file is checked against the
+// archive's manifest, and every input and page against its own metadata, before anything is written. Something that is
+// already cached (and still valid) but different from the archive is a conflict. Unless overwrite is set, any conflict
+// means nothing is imported and a *ConflictError is returned. With overwrite set, the archive wins
+func (c *Client) ImportCache(r io.Reader, overwrite bool) (*ImportResult, error) {
+	files, err := readArchive(r)
+	if err != nil {
+		return nil, err
+	}
+
+	toImport, err := collectImportFiles(files)
+	if err != nil {
+		return nil, err
+	}
+
+	c.answersMu.Lock()
+	defer c.answersMu.Unlock()
+	c.ledgerMu.Lock()
+	defer c.ledgerMu.Unlock()
+
+	cache := c.Cache()
+	res := &ImportResult{}
+	var conflicts []string
+
+	var changed []importFile
+	for _, f := range toImport {
+		var existing []byte
+		var err error
+		if f.page {
+			existing, err = cache.Page(f.num)
+		} else {
+			existing, _, err = cache.read(f.num, f.kind)
+		}
+
+		switch {
+		case errors.Is(err, ErrNoInput):
+			// not cached, or not worth keeping
+			changed = append(changed, f)
+		case err != nil:
+			return nil, err
+		case bytes.Equal(existing, f.data):
+			res.Unchanged = append(res.Unchanged, f.name)
+		default:
+			conflicts = append(conflicts, fmt.Sprintf("%s differs from the cached copy", f.name))
+			changed = append(changed, f)
+		}
+	}
+
+	var answers ExpectedAnswers
+	answersChanged := false
+	if b, ok := files[AnswersFileName]; ok {
+		var imported ExpectedAnswers
+		if err := json.Unmarshal(b, &imported); err != nil {
+			return nil, fmt.Errorf("downloader: ImportCache: could not parse %s: %w", AnswersFileName, err)
+		}
+
+		answers, err = c.readAnswers()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, num := range slices.Sorted(maps.Keys(imported)) {
+			for _, kind := range []Kind{RealInput, TestInput} {
+				ans, ok := imported[num][kind]
+				if !ok {
+					continue
+				}
+
+				existing, ok := answers[num][kind]
+				if ok && existing == ans {
+					continue
+				}
+				if ok {
+					conflicts = append(conflicts, fmt.Sprintf("expected answer for puzzle %d (%s) is %q in the archive but %q in the cache", num, kind, ans, existing))
+				}
+
+				if answers[num] == nil {
+					answers[num] = map[Kind]string{}
+				}
+				answers[num][kind] = ans
+				answersChanged = true
+			}
+		}
+
+		if answersChanged {
+			res.Imported = append(res.Imported, AnswersFileName)
+		} else {
+			res.Unchanged = append(res.Unchanged, AnswersFileName)
+		}
+	}
+
+	var l ledger
+	ledgerChanged := false
+	if b, ok := files[LedgerFileName]; ok {
+		var imported ledger
+		if err := json.Unmarshal(b, &imported); err != nil {
+			return nil, fmt.Errorf("downloader: ImportCache: could not parse %s: %w", LedgerFileName, err)
+		}
+
+		l, err = c.readLedger()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, num := range slices.Sorted(maps.Keys(imported)) {
+			for _, entry := range imported[num] {
+				existing, ok := l.lookup(num, entry.Answer)
+				if ok && existing.Status == entry.Status {
+					continue
+				}
+				if ok {
+					conflicts = append(conflicts, fmt.Sprintf("answer %q for puzzle %d is %s in the archive but %s in the cache", entry.Answer, num, entry.Status, existing.Status))
+					l[num] = slices.DeleteFunc(l[num], func(e ledgerEntry) bool {
+						return e.Answer == entry.Answer
+					})
+				}
+
+				l[num] = append(l[num], entry)
+				ledgerChanged = true
+			}
+		}
+
+		if ledgerChanged {
+			res.Imported = append(res.Imported, LedgerFileName)
+		} else {
+			res.Unchanged = append(res.Unchanged, LedgerFileName)
+		}
+	}
+
+	if len(conflicts) > 0 && !overwrite {
+		return nil, &ConflictError{Conflicts: conflicts}
+	}
+
+	for _, f := range changed {
+		if f.page {
+			err = cache.StorePage(f.num, f.data, f.meta)
+		} else {
+			err = cache.StoreWithMetadata(f.num, f.kind, f.data, f.meta)
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.Imported = append(res.Imported, f.name)
+	}
+
+	if answersChanged {
+		if err := c.writeAnswers(answers); err != nil {
+			return nil, err
+		}
+	}
+	if ledgerChanged {
+		if err := c.writeLedger(l); err != nil {
+			return nil, err
+		}
+	}
+
+	slices.Sort(res.Imported)
+	slices.Sort(res.Unchanged)
+
+	return res, nil
+}
+
+// collectImportFiles pairs up each input and page in an archive with its metadata, and checks one against the other
+func collectImportFiles(files map[string][]byte) ([]importFile, error) {
+	var ret []importFile
+	for name, data := range files {
+		m := archiveFileRegex.FindStringSubmatch(name)
+		if m == nil {
+			continue
+		}
+
+		var f importFile
+		switch {
+		case m[3] == "txt":
+			num, err := strconv.Atoi(m[1])
+			if err != nil {
+				return nil, fmt.Errorf("downloader: ImportCache: %s: bad puzzle number: %w", name, err)
+			}
+			f = importFile{name: name, num: num, kind: RealInput}
+			if m[2] != "" {
+				f.kind = TestInput
+			}
+			f.metaName = filepath.Base(getMetadataFile("", f.num, f.kind))
+		case m[5] == "html":
+			num, err := strconv.Atoi(m[4])
+			if err != nil {
+				return nil, fmt.Errorf("downloader: ImportCache: %s: bad puzzle number: %w", name, err)
+			}
+			f = importFile{name: name, num: num, page: true}
+			f.metaName = filepath.Base(getPageMetadataFile("", f.num))
+		default:
+			continue
+		}
+		f.data = data
+
+		metaBytes, ok := files[f.metaName]
+		if !ok {
+			return nil, fmt.Errorf("downloader: ImportCache: %s has no %s", name, f.metaName)
+		}
+		if err := json.Unmarshal(metaBytes, &f.meta); err != nil {
+			return nil, fmt.Errorf("downloader: ImportCache: could not parse %s: %w", f.metaName, err)
+		}
+
+		var err error
+		if f.page {
+			err = f.meta.checkSum(data)
+		} else {
+			err = f.meta.Check(data)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("downloader: ImportCache: %s: %w", name, err)
+		}
+
+		ret = append(ret, f)
+	}
+
+	// every metadata file has to belong to something
+	for name := range files {
+		if !strings.HasSuffix(name, ".meta.json") {
+			continue
+		}
+		if !slices.ContainsFunc(ret, func(f importFile) bool { return f.metaName == name }) {
+			return nil, fmt.Errorf("downloader: ImportCache: %s has nothing to describe", name)
+		}
+	}
+
+	slices.SortFunc(ret, func(a, b importFile) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	return ret, nil
+}
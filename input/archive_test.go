@@ -0,0 +1,196 @@
+package input
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newArchiveTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	c, err := NewClient(WithCacheDir(t.TempDir()), WithOffline(true))
+	require.NoError(t, err)
+
+	return c
+}
+
+func Test_ExportImportCache(t *testing.T) {
+	src := newArchiveTestClient(t)
+	cache := src.Cache()
+
+	require.NoError(t, cache.Store(1, RealInput, []byte("real input\n")))
+	require.NoError(t, cache.Store(1, TestInput, []byte("test input\n")))
+	require.NoError(t, os.WriteFile(filepath.Join(src.CacheDir(), "02.txt"), []byte("from before metadata\n"), 0664))
+	require.NoError(t, cache.Store(3, RealInput, []byte("soon to be damaged\n")))
+	require.NoError(t, os.WriteFile(filepath.Join(src.CacheDir(), "03.txt"), []byte("damaged\n"), 0664))
+	page := []byte("<html><h2>Puzzle 1: Test</h2></html>")
+	require.NoError(t, cache.StorePage(1, page, NewMetadata(page)))
+	require.NoError(t, src.SetExpectedAnswer(1, TestInput, "7"))
+	require.NoError(t, src.writeLedger(ledger{1: {{Answer: "42", Status: SubmissionCorrect}}}))
+	require.NoError(t, os.WriteFile(filepath.Join(src.CacheDir(), TokenFileName), []byte("secret"), 0600))
+
+	var archive bytes.Buffer
+	names, skipped, err := src.ExportCache(&archive)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"01-test.meta.json", "01-test.txt", "01.meta.json", "01.puzzle.html", "01.puzzle.meta.json", "01.txt",
+		"02.meta.json", "02.txt", AnswersFileName, LedgerFileName,
+	}, names)
+	require.Len(t, skipped, 1)
+	assert.Equal(t, 3, skipped[0].Puzzle)
+	assert.NotContains(t, archive.String(), "secret")
+
+	dst := newArchiveTestClient(t)
+
+	res, err := dst.ImportCache(bytes.NewReader(archive.Bytes()), false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"01-test.txt", "01.puzzle.html", "01.txt", "02.txt", AnswersFileName, LedgerFileName}, res.Imported)
+	assert.Empty(t, res.Unchanged)
+	assert.NoFileExists(t, filepath.Join(dst.CacheDir(), TokenFileName))
+
+	s, err := dst.GetInputUTF8(context.Background(), 2, RealInput)
+	require.NoError(t, err)
+	assert.Equal(t, "from before metadata\n", s)
+	assert.NotNil(t, dst.Cache().Entry(2, RealInput).Metadata, "metadata is made up for old entries")
+
+	info, err := dst.GetPuzzle(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Test", info.Title)
+
+	ans, ok, err := dst.ExpectedAnswer(1, RealInput)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "42", ans)
+
+	t.Run("importing again changes nothing", func(t *testing.T) {
+		res, err := dst.ImportCache(bytes.NewReader(archive.Bytes()), false)
+		require.NoError(t, err)
+		assert.Empty(t, res.Imported)
+		assert.Len(t, res.Unchanged, 6)
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		conflicted := newArchiveTestClient(t)
+		require.NoError(t, conflicted.Cache().Store(1, RealInput, []byte("something else\n")))
+		require.NoError(t, conflicted.SetExpectedAnswer(1, TestInput, "8"))
+
+		_, err := conflicted.ImportCache(bytes.NewReader(archive.Bytes()), false)
+		var ce *ConflictError
+		require.ErrorAs(t, err, &ce)
+		assert.Len(t, ce.Conflicts, 2)
+
+		// all or nothing
+		assert.NoFileExists(t, filepath.Join(conflicted.CacheDir(), "02.txt"))
+		s, err := conflicted.GetInputUTF8(context.Background(), 1, RealInput)
+		require.NoError(t, err)
+		assert.Equal(t, "something else\n", s)
+
+		_, err = conflicted.ImportCache(bytes.NewReader(archive.Bytes()), true)
+		require.NoError(t, err)
+
+		s, err = conflicted.GetInputUTF8(context.Background(), 1, RealInput)
+		require.NoError(t, err)
+		assert.Equal(t, "real input\n", s)
+		ans, _, err := conflicted.ExpectedAnswer(1, TestInput)
+		require.NoError(t, err)
+		assert.Equal(t, "7", ans)
+	})
+}
+
+// buildArchive makes an archive with a manifest that matches files, then adds extra files the manifest doesn't know about
+func buildArchive(t *testing.T, files map[string][]byte, extra map[string][]byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, writeArchive(&buf, files))
+	if len(extra) == 0 {
+		return buf.Bytes()
+	}
+
+	// copy the archive, adding the extra files at the end
+	gr, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+
+	var out bytes.Buffer
+	gw := gzip.NewWriter(&out)
+	tw := tar.NewWriter(gw)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			break
+		}
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err = io.Copy(tw, tr)
+		require.NoError(t, err)
+	}
+	for name, data := range extra {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Size: int64(len(data)), Mode: 0600}))
+		_, err = tw.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	return out.Bytes()
+}
+
+func Test_ImportCacheRejects(t *testing.T) {
+	input := []byte("input\n")
+	meta := []byte(`{"sha256":"` + NewMetadata(input).SHA256 + `","size":6}`)
+
+	for _, tc := range []struct {
+		name    string
+		archive []byte
+		message string
+	}{
+		{name: "not gzip", archive: []byte("hello"), message: "not a gzipped archive"},
+		{name: "token", archive: buildArchive(t, map[string][]byte{}, map[string][]byte{TokenFileName: []byte("secret")}), message: "unexpected file"},
+		{name: "path", archive: buildArchive(t, map[string][]byte{}, map[string][]byte{"../01.txt": input}), message: "unexpected file"},
+		{name: "not in manifest", archive: buildArchive(t, map[string][]byte{}, map[string][]byte{"01.txt": input}), message: "not the manifest"},
+		{name: "missing metadata", archive: buildArchive(t, map[string][]byte{"01.txt": input}, nil), message: "has no 01.meta.json"},
+		{name: "metadata mismatch", archive: buildArchive(t, map[string][]byte{"01.txt": []byte("other\n"), "01.meta.json": meta}, nil), message: "SHA-256 mismatch"},
+		{name: "stray metadata", archive: buildArchive(t, map[string][]byte{"01.txt": input, "01.meta.json": meta, "02.meta.json": meta}, nil), message: "nothing to describe"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newArchiveTestClient(t)
+
+			_, err := c.ImportCache(bytes.NewReader(tc.archive), true)
+			assert.ErrorContains(t, err, tc.message)
+
+			entries, err := c.Cache().Entries()
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+	}
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		archive := buildArchive(t, map[string][]byte{"01.txt": input, "01.meta.json": meta}, nil)
+
+		// change the input inside the tar stream, leaving the manifest as it was
+		gr, err := gzip.NewReader(bytes.NewReader(archive))
+		require.NoError(t, err)
+		var raw bytes.Buffer
+		_, err = raw.ReadFrom(gr)
+		require.NoError(t, err)
+		tampered := bytes.Replace(raw.Bytes(), []byte("input\n"), []byte("INPUT\n"), 1)
+
+		var out bytes.Buffer
+		gw := gzip.NewWriter(&out)
+		_, err = gw.Write(tampered)
+		require.NoError(t, err)
+		require.NoError(t, gw.Close())
+
+		_, err = newArchiveTestClient(t).ImportCache(&out, true)
+		assert.ErrorContains(t, err, "does not match its checksum")
+	})
+}
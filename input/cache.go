@@ -0,0 +1,342 @@
+package input
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Metadata is kept next to each cached input so we can tell whether what's on disk is what we downloaded
+type Metadata struct {
+	SHA256       string    `json:"sha256"`
+	Size         int       `json:"size"`
+	DownloadedAt time.Time `json:"downloaded_at"`
+
+	// StatusCode and ContentType are only known for inputs that came from the puzzle site
+	StatusCode  int    `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+// NewMetadata describes data that was just fetched from somewhere
+func NewMetadata(data []byte) Metadata {
+	sum := sha256.Sum256(data)
+	return Metadata{
+		SHA256:       hex.EncodeToString(sum[:]),
+		Size:         len(data),
+		DownloadedAt: time.Now().UTC(),
+	}
+}
+
+// Check makes sure data is what this metadata describes
+func (m Metadata) Check(data []byte) error {
+	if err := m.checkSum(data); err != nil {
+		return err
+	}
+
+	if isHTML(m.ContentType) {
+		return fmt.Errorf("downloader: Check: input was downloaded as %s", m.ContentType)
+	}
+
+	return nil
+}
+
+// checkSum is Check without caring what kind of content data is, for cached things (like puzzle pages) that are
+// supposed to be HTML
+func (m Metadata) checkSum(data []byte) error {
+	if len(data) != m.Size {
+		return fmt.Errorf("downloader: Check: expected %d bytes, found %d", m.Size, len(data))
+	}
+
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != m.SHA256 {
+		return fmt.Errorf("downloader: Check: SHA-256 mismatch")
+	}
+
+	return nil
+}
+
+// MetadataSource is implemented by sources that know more about where an input came from than just its bytes
+type MetadataSource interface {
+	InputWithMetadata(ctx context.Context, num int, kind Kind) ([]byte, Metadata, error)
+}
+
+// MetadataStorer is implemented by sources that can keep metadata alongside a copy of an input
+type MetadataStorer interface {
+	StoreWithMetadata(num int, kind Kind, data []byte, meta Metadata) error
+}
+
+// inputWithMetadata gets an input from any source, making up metadata for sources that can't provide their own
+func inputWithMetadata(ctx context.Context, s Source, num int, kind Kind) ([]byte, Metadata, error) {
+	if ms, ok := s.(MetadataSource); ok {
+		return ms.InputWithMetadata(ctx, num, kind)
+	}
+
+	data, err := s.Input(ctx, num, kind)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+
+	return data, NewMetadata(data), nil
+}
+
+func isHTML(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "text/html" || mediaType == "application/xhtml+xml")
+}
+
+// looksLikeHTML catches error pages that were cached before we kept metadata. No puzzle input starts like this
+func looksLikeHTML(data []byte) bool {
+	start := bytes.ToLower(bytes.TrimSpace(data[:min(len(data), 512)]))
+	return bytes.HasPrefix(start, []byte("<!doctype html")) || bytes.HasPrefix(start, []byte("<html"))
+}
+
+func getMetadataFile(directory string, num int, kind Kind) string {
+	var filename string
+	if kind == TestInput {
+		filename = fmt.Sprintf("%02d-test.meta.json", num)
+	} else {
+		filename = fmt.Sprintf("%02d.meta.json", num)
+	}
+
+	return filepath.Join(directory, filename)
+}
+
+// WriteFileAtomic writes data to a temporary file in the same directory and then renames it in to place, so readers
+// see either the old file or the new one and never a partial write. It's used for everything the input package keeps
+// on disk, and is exported so the rest of the repo can keep its own files the same way
+func WriteFileAtomic(filename string, data []byte, perm os.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := f.Name()
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpName, perm)
+	}
+	if err == nil {
+		err = os.Rename(tmpName, filename)
+	}
+	if err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+
+	return nil
+}
+
+// CacheEntry is one cached input
+type CacheEntry struct {
+	Puzzle int
+	Kind   Kind
+	Path   string
+	Size   int64
+
+	// Metadata is nil for inputs cached before metadata was kept
+	Metadata *Metadata
+
+	// Problem is why this entry can't be trusted, or nil if it's fine
+	Problem error
+}
+
+var cacheFileRegex = regexp.MustCompile(`^(\d+)(-test)?\.txt$`)
+
+// Entries lists every input in the cache, checking each one against its metadata
+func (fs *FileSource) Entries() ([]CacheEntry, error) {
+	dir, err := os.ReadDir(fs.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("downloader: Entries: could not list cache directory: %w", err)
+	}
+
+	var ret []CacheEntry
+	for _, curr := range dir {
+		m := cacheFileRegex.FindStringSubmatch(curr.Name())
+		if m == nil || curr.IsDir() {
+			continue
+		}
+
+		num, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+		kind := RealInput
+		if m[2] != "" {
+			kind = TestInput
+		}
+
+		ret = append(ret, fs.Entry(num, kind))
+	}
+
+	slices.SortFunc(ret, func(a, b CacheEntry) int {
+		if a.Puzzle != b.Puzzle {
+			return a.Puzzle - b.Puzzle
+		}
+		return int(a.Kind) - int(b.Kind)
+	})
+
+	return ret, nil
+}
+
+// Entry checks a single cached input. If the input isn't cached at all, Problem wraps ErrNoInput
+func (fs *FileSource) Entry(num int, kind Kind) CacheEntry {
+	e := CacheEntry{
+		Puzzle: num,
+		Kind:   kind,
+		Path:   getInputFile(fs.Directory, num, kind),
+	}
+
+	_, e.Metadata, e.Problem = fs.read(num, kind)
+	if info, err := os.Stat(e.Path); err == nil {
+		e.Size = info.Size()
+	}
+
+	return e
+}
+
+// read reads a cached input and its metadata, checking one against the other. Any problem with the entry is reported
+// as ErrNoInput (along with why), so a bad entry is treated like a cache miss and downloaded again
+func (fs *FileSource) read(num int, kind Kind) ([]byte, *Metadata, error) {
+	inputFile := getInputFile(fs.Directory, num, kind)
+
+	data, err := os.ReadFile(inputFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, ErrNoInput
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("downloader: FileSource: could not read existing input file: %s: %w", inputFile, err)
+	}
+
+	metaFile := getMetadataFile(fs.Directory, num, kind)
+	metaBytes, err := os.ReadFile(metaFile)
+	if errors.Is(err, os.ErrNotExist) {
+		// cached before we kept metadata. The best we can do is make sure it isn't an error page
+		if looksLikeHTML(data) {
+			return nil, nil, fmt.Errorf("%w: %s looks like an HTML page", ErrNoInput, inputFile)
+		}
+		return data, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("downloader: FileSource: could not read metadata: %s: %w", metaFile, err)
+	}
+
+	var meta Metadata
+	err = json.Unmarshal(metaBytes, &meta)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s: could not parse metadata: %w", ErrNoInput, metaFile, err)
+	}
+
+	err = meta.Check(data)
+	if err != nil {
+		return nil, &meta, fmt.Errorf("%w: %s: %w", ErrNoInput, inputFile, err)
+	}
+
+	return data, &meta, nil
+}
+
+// ErrChecksumMismatch is returned at the end of reading a cached input opened with Open if what was read doesn't match
+// the input's metadata. Everything before it was read from a damaged file, so it shouldn't be trusted
+var ErrChecksumMismatch = errors.New("downloader: cached input doesn't match its metadata")
+
+// Open opens a cached input for reading without loading all of it in to memory. The input is checked against its
+// metadata as it is read rather than up front, so the final Read returns an error wrapping ErrChecksumMismatch instead
+// of io.EOF if it turns out to be damaged. Inputs that can't be checked that way (no metadata, or metadata that already
+// says the input is bad) are reported as ErrNoInput, the same as anything that isn't cached
+func (fs *FileSource) Open(num int, kind Kind) (io.ReadCloser, error) {
+	inputFile := getInputFile(fs.Directory, num, kind)
+	metaFile := getMetadataFile(fs.Directory, num, kind)
+
+	metaBytes, err := os.ReadFile(metaFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s: no metadata to check the input against", ErrNoInput, inputFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("downloader: FileSource: could not read metadata: %s: %w", metaFile, err)
+	}
+
+	var meta Metadata
+	if err := json.Unmarshal(metaBytes, &meta); err != nil {
+		return nil, fmt.Errorf("%w: %s: could not parse metadata: %w", ErrNoInput, metaFile, err)
+	}
+	if isHTML(meta.ContentType) {
+		return nil, fmt.Errorf("%w: %s: input was downloaded as %s", ErrNoInput, inputFile, meta.ContentType)
+	}
+
+	f, err := os.Open(inputFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoInput
+	}
+	if err != nil {
+		return nil, fmt.Errorf("downloader: FileSource: could not open input file: %s: %w", inputFile, err)
+	}
+
+	// a file of the wrong size can be turned away now, anything else has to wait until it has all been read
+	if info, err := f.Stat(); err == nil && info.Size() != int64(meta.Size) {
+		f.Close()
+		return nil, fmt.Errorf("%w: %s: expected %d bytes, found %d", ErrNoInput, inputFile, meta.Size, info.Size())
+	}
+
+	return &checkedReader{f: f, name: inputFile, meta: meta, hash: sha256.New()}, nil
+}
+
+// checkedReader hashes a cached input as it is read, and checks it against the metadata at the end
+type checkedReader struct {
+	f    *os.File
+	name string
+	meta Metadata
+
+	hash hash.Hash
+	size int
+}
+
+func (cr *checkedReader) Read(p []byte) (int, error) {
+	n, err := cr.f.Read(p)
+	cr.hash.Write(p[:n])
+	cr.size += n
+
+	if errors.Is(err, io.EOF) {
+		if cr.size != cr.meta.Size {
+			return n, fmt.Errorf("%w: %s: expected %d bytes, read %d", ErrChecksumMismatch, cr.name, cr.meta.Size, cr.size)
+		}
+		if hex.EncodeToString(cr.hash.Sum(nil)) != cr.meta.SHA256 {
+			return n, fmt.Errorf("%w: %s: SHA-256 mismatch", ErrChecksumMismatch, cr.name)
+		}
+	}
+
+	return n, err
+}
+
+func (cr *checkedReader) Close() error {
+	return cr.f.Close()
+}
+
+// Purge removes a cached input and its metadata. Purging something that isn't cached is not an error
+func (fs *FileSource) Purge(num int, kind Kind) error {
+	for _, curr := range []string{getMetadataFile(fs.Directory, num, kind), getInputFile(fs.Directory, num, kind)} {
+		err := os.Remove(curr)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("downloader: Purge: could not remove %s: %w", curr, err)
+		}
+	}
+
+	return nil
+}
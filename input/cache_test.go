@@ -0,0 +1,129 @@
+package input
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input/inputtest"
+)
+
+func Test_FileSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("store writes metadata and reads it back", func(t *testing.T) {
+		dir := t.TempDir()
+		fs := &FileSource{Directory: dir}
+
+		require.NoError(t, fs.Store(2, RealInput, []byte("hello")))
+		assert.FileExists(t, filepath.Join(dir, "02.meta.json"))
+
+		data, meta, err := fs.InputWithMetadata(ctx, 2, RealInput)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("hello"), data)
+		assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", meta.SHA256)
+		assert.Equal(t, 5, meta.Size)
+
+		// no temp files left lying around
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, files, 2)
+	})
+
+	t.Run("corrupted input is a cache miss", func(t *testing.T) {
+		dir := t.TempDir()
+		fs := &FileSource{Directory: dir}
+
+		require.NoError(t, fs.Store(2, TestInput, []byte("hello")))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "02-test.txt"), []byte("hel"), 0664))
+
+		_, err := fs.Input(ctx, 2, TestInput)
+		assert.ErrorIs(t, err, ErrNoInput)
+
+		e := fs.Entry(2, TestInput)
+		assert.Error(t, e.Problem)
+		assert.NotNil(t, e.Metadata)
+	})
+
+	t.Run("old cache entries without metadata", func(t *testing.T) {
+		dir := t.TempDir()
+		fs := &FileSource{Directory: dir}
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "03.txt"), []byte("fine"), 0664))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "04.txt"), []byte("<!DOCTYPE html><html>log in</html>"), 0664))
+
+		data, err := fs.Input(ctx, 3, RealInput)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("fine"), data)
+
+		_, err = fs.Input(ctx, 4, RealInput)
+		assert.ErrorIs(t, err, ErrNoInput)
+	})
+
+	t.Run("list and purge", func(t *testing.T) {
+		dir := t.TempDir()
+		fs := &FileSource{Directory: dir}
+
+		require.NoError(t, fs.Store(10, TestInput, []byte("a")))
+		require.NoError(t, fs.Store(2, RealInput, []byte("b")))
+		require.NoError(t, fs.Store(10, RealInput, []byte("c")))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, TokenFileName), []byte("secret"), 0600))
+
+		entries, err := fs.Entries()
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, 2, entries[0].Puzzle)
+		assert.Equal(t, 10, entries[1].Puzzle)
+		assert.Equal(t, RealInput, entries[1].Kind)
+		assert.Equal(t, TestInput, entries[2].Kind)
+		for _, e := range entries {
+			assert.NoError(t, e.Problem)
+			assert.Equal(t, int64(1), e.Size)
+		}
+
+		require.NoError(t, fs.Purge(10, TestInput))
+		require.NoError(t, fs.Purge(11, TestInput))
+		assert.NoFileExists(t, filepath.Join(dir, "10-test.txt"))
+		assert.NoFileExists(t, filepath.Join(dir, "10-test.meta.json"))
+		assert.FileExists(t, filepath.Join(dir, "10.txt"))
+	})
+}
+
+func Test_HTTPSourceRejectsHTML(t *testing.T) {
+	// an expired session gets the login page instead of an error
+	srv := inputtest.NewServer(t, "")
+	srv.SetInput(1, []byte("input"))
+	srv.Inject(inputtest.Fault{Status: http.StatusOK, ContentType: "text/html; charset=utf-8", Body: "<html><body>please log in</body></html>"})
+
+	dir := t.TempDir()
+	c, err := NewClient(WithBaseURL(srv.URL), WithCacheDir(dir), WithTokenProvider(StaticToken("expired")))
+	require.NoError(t, err)
+
+	_, err = c.GetInputBytes(context.Background(), 1, RealInput)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.NotContains(t, err.Error(), "please log in")
+	assert.NoFileExists(t, filepath.Join(dir, "01.txt"))
+}
+
+func Test_ChainSourceKeepsMetadata(t *testing.T) {
+	srv := inputtest.NewServer(t, "secret")
+	srv.SetInput(1, []byte("input"))
+
+	dir := t.TempDir()
+	c, err := NewClient(WithBaseURL(srv.URL), WithCacheDir(dir), WithTokenProvider(StaticToken("secret")))
+	require.NoError(t, err)
+
+	_, err = c.GetInputBytes(context.Background(), 1, RealInput)
+	require.NoError(t, err)
+
+	e := c.Cache().Entry(1, RealInput)
+	assert.NoError(t, e.Problem)
+	require.NotNil(t, e.Metadata)
+	assert.Equal(t, http.StatusOK, e.Metadata.StatusCode)
+	assert.Equal(t, "text/plain; charset=utf-8", e.Metadata.ContentType)
+}
@@ -0,0 +1,265 @@
+package input
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// TokenEnvVar is checked for a session token before falling back to the token file in the cache directory
+	TokenEnvVar = "I18N_PUZZLES_TOKEN"
+
+	// OfflineEnvVar puts clients in offline mode (see WithOffline) when set to something true, like "1" or "true"
+	OfflineEnvVar = "I18N_PUZZLES_OFFLINE"
+
+	// BaseURLEnvVar points clients at a different server (see WithBaseURL), such as cmd/mockserver
+	BaseURLEnvVar = "I18N_PUZZLES_BASE_URL"
+
+	DefaultUserAgent = "i18n-puzzle downloader by LtHummus <lthummus.com>"
+)
+
+// Client fetches puzzle inputs from its Source. The package level Get* functions use a Client backed by the cache in
+// ~/.i18n-puzzles and the puzzle site
+type Client struct {
+	Source Source
+
+	cacheDir   string
+	baseURL    string
+	httpClient *http.Client
+	token      TokenProvider
+	userAgent  string
+	retry      RetryPolicy
+	limiter    *rate.Limiter
+	offline    bool
+	logger     *slog.Logger
+
+	prefetchWorkers int
+
+	inflight inflightFetches
+
+	ledgerMu  sync.Mutex
+	answersMu sync.Mutex
+}
+
+// Option configures a Client built by NewClient
+type Option func(c *Client)
+
+// WithBaseURL points the client at a different server, such as a local mirror. This overrides $I18N_PUZZLES_BASE_URL
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithCacheDir sets the directory downloaded inputs are cached in (and where the token file is looked for). It is
+// created if it does not exist
+func WithCacheDir(dir string) Option {
+	return func(c *Client) {
+		c.cacheDir = dir
+	}
+}
+
+func WithTokenProvider(tp TokenProvider) Option {
+	return func(c *Client) {
+		c.token = tp
+	}
+}
+
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithRetryPolicy changes how failed downloads are retried. Use NoRetries to only ever make one request
+func WithRetryPolicy(rp RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = rp
+	}
+}
+
+// WithRateLimit limits how many requests a second the client makes to the server, with bursts of up to burst requests.
+// The limit is shared by everything using the client. rate.Inf turns rate limiting off
+func WithRateLimit(limit rate.Limit, burst int) Option {
+	return func(c *Client) {
+		c.limiter = rate.NewLimiter(limit, burst)
+	}
+}
+
+// WithOffline stops the client from ever contacting the server. Anything that isn't already cached fails with
+// ErrNotCached instead of being downloaded. This overrides $I18N_PUZZLES_OFFLINE either way
+func WithOffline(offline bool) Option {
+	return func(c *Client) {
+		c.offline = offline
+	}
+}
+
+// WithLogger sends the client's diagnostic output (what is being downloaded, cached or ignored, and prefetch progress)
+// to l. Without it, the client is silent
+func WithLogger(l *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// WithSource replaces the default cache + HTTP source chain entirely
+func WithSource(s Source) Option {
+	return func(c *Client) {
+		c.Source = s
+	}
+}
+
+// NewClient builds a Client. With no options, it behaves the same as the package level functions: inputs are cached in
+// ~/.i18n-puzzles and downloaded from BaseURL using the token in $I18N_PUZZLES_TOKEN or ~/.i18n-puzzles/.token. The
+// token is only read when something actually has to be downloaded, so cached inputs work without one
+func NewClient(opts ...Option) (*Client, error) {
+	c := &Client{
+		baseURL:    BaseURL,
+		httpClient: http.DefaultClient,
+		userAgent:  DefaultUserAgent,
+		retry:      DefaultRetryPolicy,
+		limiter:    rate.NewLimiter(DefaultRateLimit, DefaultRateBurst),
+		logger:     discardLogger,
+
+		prefetchWorkers: DefaultPrefetchWorkers,
+	}
+
+	if x := strings.TrimSpace(os.Getenv(OfflineEnvVar)); x != "" {
+		offline, err := strconv.ParseBool(x)
+		if err != nil {
+			return nil, fmt.Errorf("downloader: NewClient: invalid value for $%s: %q", OfflineEnvVar, x)
+		}
+		c.offline = offline
+	}
+
+	if x := strings.TrimSpace(os.Getenv(BaseURLEnvVar)); x != "" {
+		WithBaseURL(x)(c)
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.cacheDir == "" {
+		dir, err := getPuzzleDirectory(c.logger)
+		if err != nil {
+			return nil, err
+		}
+		c.cacheDir = dir
+	} else if err := ensureDirectory(c.cacheDir, c.logger); err != nil {
+		return nil, err
+	}
+
+	if c.token == nil {
+		c.token = FirstToken(TokenFromEnv(TokenEnvVar), TokenFromFile(tokenFile(c.cacheDir)))
+	}
+
+	if c.Source == nil {
+		c.Source = ChainSource{
+			c.Cache(),
+			c.httpSource(),
+		}
+	}
+
+	return c, nil
+}
+
+func (c *Client) httpSource() *HTTPSource {
+	return &HTTPSource{
+		baseURL:   c.baseURL,
+		token:     c.token,
+		client:    c.httpClient,
+		userAgent: c.userAgent,
+		retry:     c.retry,
+		limiter:   c.limiter,
+		offline:   c.offline,
+		logger:    c.logger,
+	}
+}
+
+// Offline is whether the client is in offline mode (see WithOffline)
+func (c *Client) Offline() bool {
+	return c.offline
+}
+
+// CacheDir returns the directory this client caches inputs in
+func (c *Client) CacheDir() string {
+	return c.cacheDir
+}
+
+// Cache gives access to the inputs cached in CacheDir, for listing, checking and purging them
+func (c *Client) Cache() *FileSource {
+	return &FileSource{Directory: c.cacheDir, Logger: c.logger}
+}
+
+// GetInputBytes gets a puzzle's input from the client's Source. Callers asking for the same input at the same time
+// share one fetch, which keeps going until it finishes or all of them have given up
+func (c *Client) GetInputBytes(ctx context.Context, num int, k Kind) ([]byte, error) {
+	input, shared, err := c.inflight.do(ctx, fmt.Sprintf("%d/%s", num, k), func(ctx context.Context) ([]byte, error) {
+		return c.Source.Input(ctx, num, k)
+	})
+	if err != nil && ctx.Err() != nil {
+		// we stopped waiting, the fetch (if anyone else still wants it) carries on without us
+		return nil, fmt.Errorf("downloader: GetInputBytes: puzzle %d (input kind = %s): %w", num, k, err)
+	}
+
+	if errors.Is(err, ErrNoInput) {
+		return nil, fmt.Errorf("downloader: GetInputBytes: no input for puzzle %d (input kind = %s): %w", num, k, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if shared {
+		// everyone who asked at the same time got the same slice, so make sure nobody can change anyone else's copy
+		input = bytes.Clone(input)
+	}
+
+	return input, nil
+}
+
+func (c *Client) GetInputUTF8(ctx context.Context, num int, k Kind) (string, error) {
+	input, err := c.GetInputBytes(ctx, num, k)
+	if err != nil {
+		return "", err
+	}
+
+	return string(input), nil
+}
+
+func (c *Client) GetInputLinesUTF8(ctx context.Context, num int, k Kind) ([]string, error) {
+	input, err := c.GetInputUTF8(ctx, num, k)
+	if err != nil {
+		return nil, err
+	}
+
+	return SplitLines(input), nil
+}
+
+// SplitLines splits input in to lines, dropping the empty "line" after a trailing newline
+func SplitLines(input string) []string {
+	potential := strings.Split(input, "\n")
+
+	// last one might be empty
+	if potential[len(potential)-1] == "" {
+		return potential[:len(potential)-1]
+	} else {
+		return potential
+	}
+}
@@ -0,0 +1,191 @@
+package input
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input/inputtest"
+)
+
+func Test_NewClient(t *testing.T) {
+	srv := inputtest.NewServer(t, "from-callback")
+	srv.SetInput(4, []byte("line 1\nline 2\n"))
+
+	dir := filepath.Join(t.TempDir(), "cache")
+
+	c, err := NewClient(
+		WithBaseURL(srv.URL+"/"),
+		WithHTTPClient(srv.Client()),
+		WithCacheDir(dir),
+		WithUserAgent("test-agent"),
+		WithTokenProvider(func(_ context.Context) (string, error) {
+			return "from-callback", nil
+		}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, dir, c.CacheDir())
+
+	lines, err := c.GetInputLinesUTF8(context.Background(), 4, RealInput)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"line 1", "line 2"}, lines)
+	assert.Equal(t, "test-agent", srv.Requests()[0].UserAgent)
+	assert.FileExists(t, filepath.Join(dir, "04.txt"))
+
+	t.Run("base URL from the environment", func(t *testing.T) {
+		t.Setenv(BaseURLEnvVar, srv.URL+"/")
+
+		c, err := NewClient(WithCacheDir(t.TempDir()), WithTokenProvider(StaticToken("from-callback")))
+		require.NoError(t, err)
+
+		s, err := c.GetInputUTF8(context.Background(), 4, RealInput)
+		assert.NoError(t, err)
+		assert.Equal(t, "line 1\nline 2\n", s)
+
+		// the option wins over the environment
+		c, err = NewClient(WithCacheDir(t.TempDir()), WithTokenProvider(StaticToken("from-callback")), WithBaseURL("http://127.0.0.1:1"))
+		require.NoError(t, err)
+		_, err = c.GetInputUTF8(context.Background(), 4, RealInput)
+		assert.Error(t, err)
+	})
+}
+
+func Test_TokenProviders(t *testing.T) {
+	t.Run("env var wins over file", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, TokenFileName), []byte("from-file\n"), 0600))
+		t.Setenv("TEST_I18N_TOKEN", "from-env")
+
+		tp := FirstToken(TokenFromEnv("TEST_I18N_TOKEN"), TokenFromFile(filepath.Join(dir, TokenFileName)))
+		token, err := tp(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "from-env", token)
+	})
+
+	t.Run("falls back to file", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, TokenFileName), []byte("from-file\n"), 0600))
+		t.Setenv("TEST_I18N_TOKEN", "")
+
+		tp := FirstToken(TokenFromEnv("TEST_I18N_TOKEN"), TokenFromFile(filepath.Join(dir, TokenFileName)))
+		token, err := tp(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "from-file", token)
+	})
+
+	t.Run("nothing available", func(t *testing.T) {
+		t.Setenv("TEST_I18N_TOKEN", "")
+
+		tp := FirstToken(TokenFromEnv("TEST_I18N_TOKEN"), TokenFromFile(filepath.Join(t.TempDir(), TokenFileName)))
+		_, err := tp(context.Background())
+		assert.ErrorIs(t, err, ErrNoToken)
+	})
+}
+
+func Test_Offline(t *testing.T) {
+	srv := inputtest.NewServer(t, "abc")
+	srv.SetInput(2, []byte("downloaded\n"))
+
+	noToken := func(_ context.Context) (string, error) {
+		return "", ErrNoToken
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, (&FileSource{Directory: dir}).Store(1, RealInput, []byte("cached\n")))
+
+	t.Run("cached inputs don't need a token", func(t *testing.T) {
+		c, err := NewClient(WithBaseURL(srv.URL), WithHTTPClient(srv.Client()), WithCacheDir(dir), WithTokenProvider(noToken))
+		require.NoError(t, err)
+
+		s, err := c.GetInputUTF8(context.Background(), 1, RealInput)
+		assert.NoError(t, err)
+		assert.Equal(t, "cached\n", s)
+
+		_, err = c.GetInputUTF8(context.Background(), 2, RealInput)
+		assert.ErrorIs(t, err, ErrNoToken)
+	})
+
+	t.Run("option", func(t *testing.T) {
+		c, err := NewClient(WithBaseURL(srv.URL), WithHTTPClient(srv.Client()), WithCacheDir(dir), WithTokenProvider(noToken), WithOffline(true))
+		require.NoError(t, err)
+		assert.True(t, c.Offline())
+
+		s, err := c.GetInputUTF8(context.Background(), 1, RealInput)
+		assert.NoError(t, err)
+		assert.Equal(t, "cached\n", s)
+
+		_, err = c.GetInputBytes(context.Background(), 2, RealInput)
+		assert.ErrorIs(t, err, ErrNotCached)
+
+		_, err = c.GetPuzzle(context.Background(), 1)
+		assert.ErrorIs(t, err, ErrNotCached)
+
+		_, err = c.SubmitAnswer(context.Background(), 1, "42")
+		assert.ErrorIs(t, err, ErrNotCached)
+	})
+
+	t.Run("env var", func(t *testing.T) {
+		t.Setenv(OfflineEnvVar, "1")
+
+		c, err := NewClient(WithBaseURL(srv.URL), WithHTTPClient(srv.Client()), WithCacheDir(dir), WithTokenProvider(StaticToken("abc")))
+		require.NoError(t, err)
+		assert.True(t, c.Offline())
+
+		_, err = c.GetInputBytes(context.Background(), 2, RealInput)
+		assert.ErrorIs(t, err, ErrNotCached)
+
+		// the option wins over the environment
+		c, err = NewClient(WithBaseURL(srv.URL), WithHTTPClient(srv.Client()), WithCacheDir(dir), WithTokenProvider(StaticToken("abc")), WithOffline(false))
+		require.NoError(t, err)
+		assert.False(t, c.Offline())
+
+		t.Setenv(OfflineEnvVar, "sometimes")
+		_, err = NewClient(WithCacheDir(dir))
+		assert.ErrorContains(t, err, OfflineEnvVar)
+	})
+
+	assert.Empty(t, srv.Requests(), "nothing should have been downloaded")
+}
+
+func Test_WithLogger(t *testing.T) {
+	srv := inputtest.NewServer(t, "abc")
+	srv.SetInput(1, []byte("downloaded\n"))
+
+	dir := t.TempDir()
+	require.NoError(t, (&FileSource{Directory: dir}).Store(1, RealInput, []byte("cached\n")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "01.txt"), []byte("damaged\n"), 0664))
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey || a.Key == "err" || a.Key == "path" {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	c, err := NewClient(WithBaseURL(srv.URL), WithHTTPClient(srv.Client()), WithCacheDir(dir), WithTokenProvider(StaticToken("abc")), WithLogger(logger))
+	require.NoError(t, err)
+
+	_, err = c.GetInputBytes(context.Background(), 1, RealInput)
+	require.NoError(t, err)
+
+	assert.Equal(t, `level=WARN msg="ignoring cached input" puzzle=1 kind=real
+level=INFO msg="downloading input" puzzle=1 kind=real
+level=DEBUG msg="cached input" puzzle=1 kind=real
+`, buf.String())
+
+	t.Run("quiet by default", func(t *testing.T) {
+		c, err := NewClient(WithCacheDir(dir), WithOffline(true))
+		require.NoError(t, err)
+		assert.False(t, c.logger.Enabled(context.Background(), slog.LevelError))
+	})
+}
@@ -0,0 +1,106 @@
+package input
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// These are matched with errors.Is against errors from fetching inputs or submitting answers. The error itself is an
+// *HTTPError with more detail
+var (
+	// ErrUnauthorized means the server didn't accept our session token, most likely because it has expired
+	ErrUnauthorized = errors.New("downloader: not logged in (session token missing or expired)")
+
+	// ErrPuzzleLocked means the puzzle exists but hasn't been released yet
+	ErrPuzzleLocked = errors.New("downloader: puzzle has not been released yet")
+
+	ErrNotFound = errors.New("downloader: not found")
+
+	// ErrRateLimited means the server told us to slow down, and kept saying so after any retries
+	ErrRateLimited = errors.New("downloader: rate limited by server")
+
+	ErrServerError = errors.New("downloader: server error")
+
+	// ErrNotCached means a client in offline mode was asked for something it would have had to download
+	ErrNotCached = errors.New("downloader: not cached, and the client is offline")
+)
+
+// HTTPError is an unsuccessful response from the puzzle site
+type HTTPError struct {
+	StatusCode int
+	URL        string
+
+	// Message is a short excerpt of what the server said, if it said anything useful (HTML pages are left out)
+	Message string
+
+	// Err is one of the sentinel errors above, or nil if the response didn't fit any of them
+	Err error
+}
+
+func (e *HTTPError) Error() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "downloader: %s: %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Err != nil {
+		fmt.Fprintf(&sb, ": %s", strings.TrimPrefix(e.Err.Error(), "downloader: "))
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&sb, ": %s", e.Message)
+	}
+
+	return sb.String()
+}
+
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// phrases the site uses on pages for puzzles that aren't out yet
+var lockedPhrases = []string{"not yet available", "not available yet", "not been released", "not released yet", "unlocks", "will be available"}
+
+const maxMessageLength = 200
+
+// newHTTPError works out what kind of failure a response was
+func newHTTPError(url string, resp *response) *HTTPError {
+	e := &HTTPError{
+		StatusCode: resp.StatusCode,
+		URL:        url,
+	}
+
+	html := isHTML(resp.Header.Get("Content-Type")) || looksLikeHTML(resp.Body)
+	if !html {
+		e.Message = strings.TrimSpace(string(resp.Body))
+		if len(e.Message) > maxMessageLength {
+			e.Message = strings.ToValidUTF8(e.Message[:maxMessageLength], "") + "..."
+		}
+	}
+
+	lowerBody := strings.ToLower(string(resp.Body))
+	locked := false
+	for _, curr := range lockedPhrases {
+		if strings.Contains(lowerBody, curr) {
+			locked = true
+			break
+		}
+	}
+
+	switch {
+	case locked && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound):
+		e.Err = ErrPuzzleLocked
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		e.Err = ErrUnauthorized
+	case resp.StatusCode == http.StatusNotFound:
+		e.Err = ErrNotFound
+	case resp.StatusCode == http.StatusTooManyRequests:
+		e.Err = ErrRateLimited
+	case resp.StatusCode >= 500:
+		e.Err = ErrServerError
+	case resp.StatusCode == http.StatusOK && html:
+		// a login page served in place of the input, which is what happens when the session has expired
+		e.Err = ErrUnauthorized
+	}
+
+	return e
+}
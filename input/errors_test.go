@@ -0,0 +1,90 @@
+package input
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input/inputtest"
+)
+
+func Test_newHTTPError(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		expected    error
+	}{
+		{"expired session", http.StatusForbidden, "text/html", "<html>log in</html>", ErrUnauthorized},
+		{"unauthorized", http.StatusUnauthorized, "text/plain", "no", ErrUnauthorized},
+		{"locked puzzle", http.StatusForbidden, "text/html", "<html>This puzzle is not yet available</html>", ErrPuzzleLocked},
+		{"locked puzzle 404", http.StatusNotFound, "text/plain", "Puzzle 30 unlocks on March 1", ErrPuzzleLocked},
+		{"not found", http.StatusNotFound, "text/plain", "nope", ErrNotFound},
+		{"rate limited", http.StatusTooManyRequests, "text/plain", "slow down", ErrRateLimited},
+		{"server error", http.StatusBadGateway, "text/plain", "upstream died", ErrServerError},
+		{"login page with a 200", http.StatusOK, "text/html; charset=utf-8", "<!DOCTYPE html><html>sign in</html>", ErrUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := newHTTPError("https://example.com/puzzle/1/input", &response{
+				StatusCode: tc.status,
+				Header:     http.Header{"Content-Type": []string{tc.contentType}},
+				Body:       []byte(tc.body),
+			})
+
+			assert.ErrorIs(t, err, tc.expected)
+
+			var he *HTTPError
+			require.True(t, errors.As(error(err), &he))
+			assert.Equal(t, tc.status, he.StatusCode)
+			assert.NotContains(t, err.Error(), "<html")
+		})
+	}
+
+	t.Run("unclassified", func(t *testing.T) {
+		err := newHTTPError("https://example.com", &response{StatusCode: http.StatusTeapot, Body: []byte("short and stout")})
+		assert.Nil(t, err.Err)
+		assert.Contains(t, err.Error(), "418")
+		assert.Contains(t, err.Error(), "short and stout")
+	})
+
+	t.Run("long messages are cut short", func(t *testing.T) {
+		err := newHTTPError("https://example.com", &response{StatusCode: http.StatusBadRequest, Body: []byte(strings.Repeat("💩", 100))})
+		assert.Less(t, len(err.Message), 220)
+		assert.True(t, strings.HasSuffix(err.Message, "..."))
+	})
+}
+
+func Test_TypedErrorsFromClient(t *testing.T) {
+	srv := inputtest.NewServer(t, "new")
+	srv.SetInput(1, []byte("input"))
+	srv.SetInput(99, []byte("input"))
+	srv.Lock(99)
+
+	ctx := context.Background()
+
+	c, err := NewClient(WithBaseURL(srv.URL), WithCacheDir(t.TempDir()), WithTokenProvider(StaticToken("new")))
+	require.NoError(t, err)
+
+	_, err = c.GetInputBytes(ctx, 99, RealInput)
+	assert.ErrorIs(t, err, ErrPuzzleLocked)
+
+	_, err = c.GetInputBytes(ctx, 2, TestInput)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	expired, err := NewClient(WithBaseURL(srv.URL), WithCacheDir(t.TempDir()), WithTokenProvider(StaticToken("old")))
+	require.NoError(t, err)
+
+	_, err = expired.GetInputBytes(ctx, 1, RealInput)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = expired.SubmitAnswer(ctx, 7, "42")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
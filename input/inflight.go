@@ -0,0 +1,82 @@
+package input
+
+import (
+	"context"
+	"sync"
+)
+
+// inflightFetches makes concurrent requests for the same input share one fetch (and one download). The fetch runs
+// under its own context, so it isn't stopped by whichever caller happened to ask first, but it is cancelled (and
+// forgotten, so the next caller starts over) once every caller waiting for it has given up. The zero value is ready to
+// use
+type inflightFetches struct {
+	mu      sync.Mutex
+	fetches map[string]*inflightFetch
+}
+
+type inflightFetch struct {
+	done   chan struct{}
+	cancel context.CancelFunc
+
+	data []byte
+	err  error
+
+	// waiting is how many callers are still waiting for the fetch, and callers how many have asked for it at all
+	waiting int
+	callers int
+}
+
+// do runs fetch, or joins the fetch already running for key. shared is set if more than one caller asked for the same
+// fetch, in which case they all get the same slice back
+func (g *inflightFetches) do(ctx context.Context, key string, fetch func(ctx context.Context) ([]byte, error)) (data []byte, shared bool, err error) {
+	g.mu.Lock()
+	if g.fetches == nil {
+		g.fetches = map[string]*inflightFetch{}
+	}
+
+	f := g.fetches[key]
+	if f == nil {
+		fetchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &inflightFetch{done: make(chan struct{}), cancel: cancel}
+		g.fetches[key] = f
+
+		go func() {
+			data, err := fetch(fetchCtx)
+
+			g.mu.Lock()
+			f.data, f.err = data, err
+			g.forget(key, f)
+			g.mu.Unlock()
+
+			cancel()
+			close(f.done)
+		}()
+	}
+	f.waiting++
+	f.callers++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		return f.data, f.callers > 1, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		f.waiting--
+		if f.waiting == 0 {
+			// nobody wants it any more, so stop it and let the next caller start a new one
+			f.cancel()
+			g.forget(key, f)
+		}
+		return nil, false, context.Cause(ctx)
+	}
+}
+
+// forget removes f from the running fetches, unless it has already been replaced by a newer one. g.mu must be held
+func (g *inflightFetches) forget(key string, f *inflightFetch) {
+	if g.fetches[key] == f {
+		delete(g.fetches, key)
+	}
+}
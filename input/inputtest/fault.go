@@ -0,0 +1,93 @@
+package inputtest
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fault makes the site misbehave for requests matching Path
+type Fault struct {
+	// Path is a pattern (see path.Match) the request path has to match, like "/puzzle/*/input". Empty matches everything
+	Path string
+
+	// Status is sent instead of the normal response. Zero means the request is answered normally (after Delay)
+	Status int
+
+	// Body, ContentType and RetryAfter go along with Status
+	Body        string
+	ContentType string
+	RetryAfter  string
+
+	// Delay is how long to wait before answering
+	Delay time.Duration
+
+	// Times is how many requests for each matching path are affected before the fault stops applying. Zero means every
+	// one of them
+	Times int
+}
+
+type fault struct {
+	Fault
+
+	// seen counts matching requests for each path
+	seen map[string]int
+}
+
+func (f *fault) matches(p string) bool {
+	if f.Path == "" {
+		return true
+	}
+
+	ok, err := path.Match(f.Path, p)
+	return ok && err == nil
+}
+
+func (f *Fault) write(w http.ResponseWriter) {
+	if f.ContentType != "" {
+		w.Header().Set("Content-Type", f.ContentType)
+	}
+	if f.RetryAfter != "" {
+		w.Header().Set("Retry-After", f.RetryAfter)
+	}
+	w.WriteHeader(f.Status)
+	w.Write([]byte(f.Body))
+}
+
+// ParseFault parses a fault from the command line, in the form PATTERN=STATUS, optionally followed by *TIMES to only
+// fail that many requests for each path. For example, "/puzzle/*/input=503*2" fails the first two attempts at every
+// real input with a 503
+func ParseFault(x string) (Fault, error) {
+	pattern, spec, ok := strings.Cut(x, "=")
+	if !ok || pattern == "" {
+		return Fault{}, fmt.Errorf("inputtest: ParseFault: expected PATTERN=STATUS[*TIMES], got %q", x)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return Fault{}, fmt.Errorf("inputtest: ParseFault: bad pattern %q: %w", pattern, err)
+	}
+
+	f := Fault{Path: pattern}
+
+	status, times, hasTimes := strings.Cut(spec, "*")
+	var err error
+	f.Status, err = strconv.Atoi(status)
+	if err != nil || f.Status < 100 || f.Status > 599 {
+		return Fault{}, fmt.Errorf("inputtest: ParseFault: bad status %q in %q", status, x)
+	}
+	if hasTimes {
+		f.Times, err = strconv.Atoi(times)
+		if err != nil || f.Times < 1 {
+			return Fault{}, fmt.Errorf("inputtest: ParseFault: bad count %q in %q", times, x)
+		}
+	}
+
+	f.Body = http.StatusText(f.Status)
+	if f.Status == http.StatusTooManyRequests {
+		f.RetryAfter = "1"
+	}
+
+	return f, nil
+}
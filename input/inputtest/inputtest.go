@@ -0,0 +1,446 @@
+// Package inputtest is a stand-in for the i18n-puzzles site, for testing code that downloads inputs, puzzle pages and
+// submits answers without going anywhere near the real thing. NewServer starts one for a test, and cmd/mockserver runs
+// one on its own for development.
+//
+// It deliberately doesn't import the input package, so the input package's own tests can use it
+package inputtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// CookieName is the cookie the session token is sent in, the same as on the real site
+const CookieName = "sessionid"
+
+const (
+	// CorrectResponse and IncorrectResponse are what the site says to a submitted answer. Like the real site, a
+	// submission is answered with a redirect to the puzzle's page, which shows one of these at the top in
+	// <ul class="messages"> (as a "success" or "error" message) the next time that session loads a page
+	CorrectResponse   = "That's the right answer!"
+	IncorrectResponse = "That's not the right answer. Please wait a minute before trying again."
+
+	// LockedResponse is the body of the 404 for a puzzle that hasn't been released
+	LockedResponse = "This puzzle is not yet available"
+)
+
+var (
+	pathRegex    = regexp.MustCompile(`^/puzzle/(\d+)/(input|test-input|submit/)?$`)
+	fixtureRegex = regexp.MustCompile(`^(\d+)(-test\.txt|\.txt|\.puzzle\.html)$`)
+	bodyRegex    = regexp.MustCompile(`(?i)<body[^>]*>`)
+)
+
+// Request is a request the site has seen
+type Request struct {
+	Method    string
+	Path      string
+	UserAgent string
+
+	// Token is the value of the session cookie, or "" if there wasn't one
+	Token string
+
+	// Answer is the submitted answer, for submissions
+	Answer string
+}
+
+// Site serves puzzle inputs (/puzzle/N/input and /puzzle/N/test-input), puzzle pages (/puzzle/N/) and answer
+// submission (/puzzle/N/submit/) the way the real site does. Inputs and submissions need the session cookie to match
+// the site's token, and pages don't. It is safe to change a Site while it is being served
+type Site struct {
+	mu sync.Mutex
+
+	token   string
+	inputs  map[string][]byte
+	pages   map[int][]byte
+	answers map[int]string
+	locked  map[int]bool
+
+	// messages are waiting to be shown on the next page each session loads, keyed by token
+	messages map[string]string
+	faults   []*fault
+
+	requests  []Request
+	active    int
+	maxActive int
+}
+
+// NewSite makes an empty site that accepts token as the session cookie. An empty token accepts anything, including no
+// cookie at all
+func NewSite(token string) *Site {
+	return &Site{
+		token:    token,
+		inputs:   map[string][]byte{},
+		pages:    map[int][]byte{},
+		answers:  map[int]string{},
+		locked:   map[int]bool{},
+		messages: map[string]string{},
+	}
+}
+
+func inputPath(num int, test bool) string {
+	if test {
+		return fmt.Sprintf("/puzzle/%d/test-input", num)
+	}
+	return fmt.Sprintf("/puzzle/%d/input", num)
+}
+
+// SetInput sets the real input for a puzzle
+func (s *Site) SetInput(num int, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inputs[inputPath(num, false)] = data
+}
+
+// SetTestInput sets the test input for a puzzle
+func (s *Site) SetTestInput(num int, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inputs[inputPath(num, true)] = data
+}
+
+// SetPage sets the HTML of a puzzle's page
+func (s *Site) SetPage(num int, page []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pages[num] = page
+}
+
+// SetAnswer sets the answer submissions for a puzzle are checked against. Puzzles without one reject every answer.
+// A puzzle with an answer but no page gets a generic one, so submissions always have a page to land on
+func (s *Site) SetAnswer(num int, answer string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.answers[num] = answer
+}
+
+// Lock makes a puzzle act like it hasn't been released yet, whatever has been set for it
+func (s *Site) Lock(num int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.locked[num] = true
+}
+
+// LoadDir loads every fixture in dir. It uses the same layout as the input cache (NN.txt, NN-test.txt and
+// NN.puzzle.html, with real input answers in answers.json) so a copy of a cache directory works as a fixtures
+// directory. Anything else in dir is ignored
+func (s *Site) LoadDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("inputtest: LoadDir: %w", err)
+	}
+
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		if e.Name() == "answers.json" {
+			if err := s.loadAnswers(filepath.Join(dir, e.Name())); err != nil {
+				return err
+			}
+			continue
+		}
+
+		m := fixtureRegex.FindStringSubmatch(e.Name())
+		if m == nil {
+			continue
+		}
+		num, err := strconv.Atoi(m[1])
+		if err != nil {
+			return fmt.Errorf("inputtest: LoadDir: bad puzzle number in %s: %w", e.Name(), err)
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return fmt.Errorf("inputtest: LoadDir: %w", err)
+		}
+
+		switch m[2] {
+		case ".txt":
+			s.SetInput(num, data)
+		case "-test.txt":
+			s.SetTestInput(num, data)
+		case ".puzzle.html":
+			s.SetPage(num, data)
+		}
+	}
+
+	return nil
+}
+
+func (s *Site) loadAnswers(file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("inputtest: LoadDir: %w", err)
+	}
+
+	// the same format as the input package's answers file, keyed by puzzle and then input kind
+	var answers map[int]map[string]string
+	if err := json.Unmarshal(data, &answers); err != nil {
+		return fmt.Errorf("inputtest: LoadDir: could not parse %s: %w", file, err)
+	}
+
+	for num, curr := range answers {
+		if ans, ok := curr["real"]; ok {
+			s.SetAnswer(num, ans)
+		}
+	}
+
+	return nil
+}
+
+// Inject adds a fault. Every request is checked against every fault, in the order they were added
+func (s *Site) Inject(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault{Fault: f, seen: map[string]int{}})
+}
+
+// ClearFaults removes every fault, so the site works normally again
+func (s *Site) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// Requests is every request the site has seen, in the order they arrived
+func (s *Site) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request{}, s.requests...)
+}
+
+// Count is how many requests the site has seen for p
+func (s *Site) Count(p string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := 0
+	for _, curr := range s.requests {
+		if curr.Path == p {
+			count++
+		}
+	}
+
+	return count
+}
+
+// MaxInFlight is the most requests the site has been handling at once
+func (s *Site) MaxInFlight() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.maxActive
+}
+
+func (s *Site) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req := Request{
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		UserAgent: r.UserAgent(),
+	}
+	if c, err := r.Cookie(CookieName); err == nil {
+		req.Token = c.Value
+	}
+	if r.Method == http.MethodPost {
+		req.Answer = r.FormValue("answer")
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.active++
+	s.maxActive = max(s.maxActive, s.active)
+	delay, failure := s.matchFaults(r.URL.Path)
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.active--
+		s.mu.Unlock()
+	}()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if failure != nil {
+		failure.write(w)
+		return
+	}
+
+	s.serve(w, req)
+}
+
+// matchFaults works out what the faults do to a request for p: how long to wait, and which one (if any) decides the
+// response. s.mu must be held
+func (s *Site) matchFaults(p string) (time.Duration, *Fault) {
+	var delay time.Duration
+	var failure *Fault
+
+	for _, f := range s.faults {
+		if !f.matches(p) {
+			continue
+		}
+
+		f.seen[p]++
+		if f.Times > 0 && f.seen[p] > f.Times {
+			continue
+		}
+
+		delay += f.Delay
+		if f.Status != 0 && failure == nil {
+			failure = &f.Fault
+		}
+	}
+
+	return delay, failure
+}
+
+func (s *Site) serve(w http.ResponseWriter, req Request) {
+	m := pathRegex.FindStringSubmatch(req.Path)
+	if m == nil {
+		http.NotFound(w, nil)
+		return
+	}
+	num, err := strconv.Atoi(m[1])
+	if err != nil {
+		http.NotFound(w, nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if m[2] == "" {
+		// puzzle pages are public
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if s.locked[num] {
+			http.Error(w, LockedResponse, http.StatusNotFound)
+			return
+		}
+		page, ok := s.pages[num]
+		if !ok {
+			if _, ok := s.answers[num]; !ok {
+				http.NotFound(w, nil)
+				return
+			}
+			page = genericPage(num)
+		}
+		if msg, ok := s.messages[req.Token]; ok {
+			delete(s.messages, req.Token)
+			page = withMessage(page, msg)
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(page)
+		return
+	}
+
+	if s.token != "" && req.Token != s.token {
+		http.Error(w, "please log in", http.StatusForbidden)
+		return
+	}
+	if s.locked[num] {
+		http.Error(w, LockedResponse, http.StatusNotFound)
+		return
+	}
+
+	if m[2] == "submit/" {
+		if req.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if ans, ok := s.answers[num]; ok && strings.TrimSpace(req.Answer) == ans {
+			s.messages[req.Token] = `<li class="success">` + CorrectResponse + `</li>`
+		} else {
+			s.messages[req.Token] = `<li class="error">` + IncorrectResponse + `</li>`
+		}
+		w.Header().Set("Location", fmt.Sprintf("/puzzle/%d/", num))
+		w.WriteHeader(http.StatusFound)
+		return
+	}
+
+	if req.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	data, ok := s.inputs[req.Path]
+	if !ok {
+		http.NotFound(w, nil)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write(data)
+}
+
+// genericPage is a page for a puzzle that doesn't have one set. Like the real thing, it talks about correct answers
+// whether or not one has been given
+func genericPage(num int) []byte {
+	return []byte(fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<head><title>Puzzle %[1]d | i18n puzzles</title></head>
+<body>
+<h3>Puzzle %[1]d: A puzzle</h3>
+<p>Work out the right answer for your input, then enter it below. You'll be told if it's correct.</p>
+<form method="post" action="/puzzle/%[1]d/submit/">
+<input type="text" name="answer"> <button type="submit">Submit</button>
+</form>
+</body>
+</html>
+`, num))
+}
+
+// withMessage puts a message (an <li>) at the top of a page's body
+func withMessage(page []byte, msg string) []byte {
+	messages := "\n<ul class=\"messages\">\n" + msg + "\n</ul>\n"
+
+	at := 0
+	if loc := bodyRegex.FindIndex(page); loc != nil {
+		at = loc[1]
+	}
+
+	ret := append([]byte{}, page[:at]...)
+	ret = append(ret, messages...)
+	return append(ret, page[at:]...)
+}
+
+// Server is a Site being served by an httptest.Server
+type Server struct {
+	*Site
+	*httptest.Server
+}
+
+// NewServer starts serving a new site (see NewSite) for the length of a test
+func NewServer(tb testing.TB, token string) *Server {
+	tb.Helper()
+
+	site := NewSite(token)
+	srv := httptest.NewServer(site)
+	tb.Cleanup(srv.Close)
+
+	return &Server{Site: site, Server: srv}
+}
@@ -0,0 +1,183 @@
+package inputtest
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, srv *Server, p string, token string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+p, nil)
+	require.NoError(t, err)
+	if token != "" {
+		req.AddCookie(&http.Cookie{Name: CookieName, Value: token})
+	}
+
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp.StatusCode, string(body)
+}
+
+func Test_Site(t *testing.T) {
+	srv := NewServer(t, "secret")
+	srv.SetInput(1, []byte("real"))
+	srv.SetTestInput(1, []byte("test"))
+	srv.SetPage(1, []byte("<html>page</html>"))
+	srv.SetAnswer(1, "42")
+	srv.SetInput(2, []byte("not yet"))
+	srv.Lock(2)
+
+	status, body := get(t, srv, "/puzzle/1/input", "secret")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "real", body)
+
+	status, body = get(t, srv, "/puzzle/1/test-input", "secret")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "test", body)
+
+	status, _ = get(t, srv, "/puzzle/1/input", "wrong")
+	assert.Equal(t, http.StatusForbidden, status)
+
+	status, body = get(t, srv, "/puzzle/1/", "")
+	assert.Equal(t, http.StatusOK, status, "pages don't need a token")
+	assert.Equal(t, "<html>page</html>", body)
+
+	status, body = get(t, srv, "/puzzle/2/input", "secret")
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Contains(t, body, LockedResponse)
+
+	status, _ = get(t, srv, "/puzzle/3/input", "secret")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	submit := func(answer string) (int, string) {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+"/puzzle/1/submit/", strings.NewReader(url.Values{"answer": {answer}}.Encode()))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: CookieName, Value: "secret"})
+
+		resp, err := srv.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.Request.Response.StatusCode, string(body)
+	}
+
+	// submissions redirect back to the puzzle page, which shows how it went once
+	status, body = submit("42")
+	assert.Equal(t, http.StatusFound, status)
+	assert.Equal(t, "\n<ul class=\"messages\">\n<li class=\"success\">"+CorrectResponse+"</li>\n</ul>\n<html>page</html>", body)
+	_, body = get(t, srv, "/puzzle/1/", "secret")
+	assert.Equal(t, "<html>page</html>", body)
+
+	_, body = submit("41")
+	assert.Contains(t, body, `<li class="error">`+IncorrectResponse+"</li>")
+
+	assert.Equal(t, 3, srv.Count("/puzzle/1/input")+srv.Count("/puzzle/1/test-input"))
+	reqs := srv.Requests()
+	assert.Equal(t, "41", reqs[len(reqs)-2].Answer)
+	assert.Equal(t, "secret", reqs[len(reqs)-1].Token)
+	assert.Equal(t, "secret", reqs[0].Token)
+
+	t.Run("generic page", func(t *testing.T) {
+		srv.SetAnswer(5, "five")
+
+		status, body := get(t, srv, "/puzzle/5/", "")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Contains(t, body, "<h3>Puzzle 5: A puzzle</h3>")
+		assert.Contains(t, body, "correct")
+		assert.NotContains(t, body, "messages")
+
+		status, _ = get(t, srv, "/puzzle/6/", "")
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
+
+func Test_Faults(t *testing.T) {
+	srv := NewServer(t, "")
+	srv.SetInput(1, []byte("one"))
+	srv.SetInput(2, []byte("two"))
+
+	srv.Inject(Fault{Path: "/puzzle/*/input", Status: http.StatusServiceUnavailable, Times: 2})
+	srv.Inject(Fault{Path: "/puzzle/2/input", Delay: 20 * time.Millisecond})
+
+	for _, p := range []string{"/puzzle/1/input", "/puzzle/2/input"} {
+		for range 2 {
+			status, _ := get(t, srv, p, "")
+			assert.Equal(t, http.StatusServiceUnavailable, status, p)
+		}
+	}
+
+	status, body := get(t, srv, "/puzzle/1/input", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "one", body)
+
+	start := time.Now()
+	status, _ = get(t, srv, "/puzzle/2/input", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	srv.ClearFaults()
+	srv.Inject(Fault{Status: http.StatusOK, ContentType: "text/html", Body: "<html>log in</html>"})
+	status, body = get(t, srv, "/puzzle/1/input", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "<html>log in</html>", body)
+}
+
+func Test_ParseFault(t *testing.T) {
+	f, err := ParseFault("/puzzle/*/input=503*2")
+	require.NoError(t, err)
+	assert.Equal(t, "/puzzle/*/input", f.Path)
+	assert.Equal(t, http.StatusServiceUnavailable, f.Status)
+	assert.Equal(t, 2, f.Times)
+
+	f, err = ParseFault("/puzzle/7/submit/=429")
+	require.NoError(t, err)
+	assert.Equal(t, 0, f.Times)
+	assert.Equal(t, "1", f.RetryAfter)
+
+	for _, bad := range []string{"", "503", "=503", "/x=abc", "/x=99", "/x=503*0", "/x=503*y", "[=503"} {
+		_, err := ParseFault(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func Test_LoadDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "01.txt"), []byte("real"), 0664))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "01-test.txt"), []byte("test"), 0664))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "01.meta.json"), []byte("{}"), 0664))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "01.puzzle.html"), []byte("<html></html>"), 0664))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "answers.json"), []byte(`{"1":{"real":"42","test":"7"}}`), 0664))
+
+	srv := NewServer(t, "")
+	require.NoError(t, srv.LoadDir(dir))
+
+	_, body := get(t, srv, "/puzzle/1/input", "")
+	assert.Equal(t, "real", body)
+	_, body = get(t, srv, "/puzzle/1/test-input", "")
+	assert.Equal(t, "test", body)
+	_, body = get(t, srv, "/puzzle/1/", "")
+	assert.Equal(t, "<html></html>", body)
+
+	srv.mu.Lock()
+	assert.Equal(t, map[int]string{1: "42"}, srv.answers)
+	srv.mu.Unlock()
+
+	assert.Error(t, srv.LoadDir(filepath.Join(dir, "missing")))
+}
@@ -0,0 +1,101 @@
+package input
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// DefaultPrefetchWorkers is how many inputs Prefetch downloads at once unless told otherwise. More than this wouldn't
+// help much, since every request still waits on the client's rate limiter
+const DefaultPrefetchWorkers = 4
+
+// WithPrefetchWorkers sets how many inputs Prefetch fetches at once
+func WithPrefetchWorkers(n int) Option {
+	return func(c *Client) {
+		c.prefetchWorkers = n
+	}
+}
+
+type prefetchJob struct {
+	num  int
+	kind Kind
+}
+
+// Prefetch makes sure every combination of nums and kinds is in the cache, downloading whatever isn't with a pool of
+// workers. Progress is logged (see WithLogger) as each input finishes. Failures don't stop the other downloads. They are
+// returned together (see errors.Join), each one saying which input it was for
+func (c *Client) Prefetch(ctx context.Context, nums []int, kinds []Kind) error {
+	var jobs []prefetchJob
+	for _, num := range nums {
+		for _, kind := range kinds {
+			jobs = append(jobs, prefetchJob{num: num, kind: kind})
+		}
+	}
+	slices.SortFunc(jobs, func(a, b prefetchJob) int {
+		if a.num != b.num {
+			return a.num - b.num
+		}
+		return int(a.kind) - int(b.kind)
+	})
+	jobs = slices.Compact(jobs)
+
+	workers := min(max(c.prefetchWorkers, 1), len(jobs))
+
+	queue := make(chan prefetchJob)
+	var wg sync.WaitGroup
+
+	var mu sync.Mutex
+	var errs []error
+	done := 0
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range queue {
+				data, err := c.GetInputBytes(ctx, job.num, job.kind)
+
+				mu.Lock()
+				done++
+				if err != nil {
+					err = fmt.Errorf("puzzle %d (%s): %w", job.num, job.kind, err)
+					errs = append(errs, err)
+					c.logger.Error("prefetch failed", "done", done, "total", len(jobs), "puzzle", job.num, "kind", job.kind, "err", err)
+				} else {
+					c.logger.Info("prefetched", "done", done, "total", len(jobs), "puzzle", job.num, "kind", job.kind, "bytes", len(data))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, job := range jobs {
+		select {
+		case queue <- job:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, fmt.Errorf("downloader: Prefetch: stopped after %d of %d inputs: %w", done, len(jobs), err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func Prefetch(ctx context.Context, nums []int, kinds []Kind) error {
+	c, err := defaultClient()
+	if err != nil {
+		return err
+	}
+
+	return c.Prefetch(ctx, nums, kinds)
+}
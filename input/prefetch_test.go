@@ -0,0 +1,201 @@
+package input
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input/inputtest"
+	"golang.org/x/time/rate"
+)
+
+func Test_Prefetch(t *testing.T) {
+	srv := inputtest.NewServer(t, "abc")
+	for _, num := range []int{1, 2, 4} {
+		srv.SetInput(num, []byte(fmt.Sprintf("input for /puzzle/%d/input\n", num)))
+		srv.SetTestInput(num, []byte(fmt.Sprintf("input for /puzzle/%d/test-input\n", num)))
+	}
+	srv.Inject(inputtest.Fault{Delay: 10 * time.Millisecond})
+
+	dir := t.TempDir()
+	c, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithHTTPClient(srv.Client()),
+		WithCacheDir(dir),
+		WithTokenProvider(StaticToken("abc")),
+		WithRetryPolicy(NoRetries),
+		WithRateLimit(rate.Inf, 1),
+		WithPrefetchWorkers(2),
+	)
+	require.NoError(t, err)
+
+	err = c.Prefetch(context.Background(), []int{1, 2, 3, 4, 1}, []Kind{RealInput, TestInput})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	require.True(t, ok)
+	assert.Len(t, joined.Unwrap(), 2)
+	assert.ErrorContains(t, err, "puzzle 3 (REAL)")
+	assert.ErrorContains(t, err, "puzzle 3 (TEST)")
+
+	for _, num := range []int{1, 2, 4} {
+		assert.FileExists(t, filepath.Join(dir, fmt.Sprintf("%02d.txt", num)))
+		assert.FileExists(t, filepath.Join(dir, fmt.Sprintf("%02d-test.txt", num)))
+		assert.Equal(t, 1, srv.Count(fmt.Sprintf("/puzzle/%d/input", num)))
+	}
+	assert.LessOrEqual(t, srv.MaxInFlight(), 2)
+
+	// everything that worked is cached now
+	err = c.Prefetch(context.Background(), []int{1, 2, 4}, []Kind{RealInput, TestInput})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, srv.Count("/puzzle/1/input"))
+}
+
+func Test_PrefetchCanceled(t *testing.T) {
+	c, err := NewClient(WithCacheDir(t.TempDir()), WithOffline(true))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = c.Prefetch(ctx, []int{1, 2, 3}, []Kind{RealInput})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// blockingSource counts calls and doesn't answer any of them until release is closed
+type blockingSource struct {
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (bs *blockingSource) Input(_ context.Context, _ int, _ Kind) ([]byte, error) {
+	bs.calls.Add(1)
+	<-bs.release
+	return []byte("shared"), nil
+}
+
+func Test_GetInputBytesSharesInflight(t *testing.T) {
+	bs := &blockingSource{release: make(chan struct{})}
+	c, err := NewClient(WithCacheDir(t.TempDir()), WithSource(bs))
+	require.NoError(t, err)
+
+	const callers = 5
+	results := make([][]byte, callers)
+	var started, wg sync.WaitGroup
+	started.Add(callers)
+	wg.Add(callers)
+	for i := range callers {
+		go func() {
+			defer wg.Done()
+			started.Done()
+
+			var err error
+			results[i], err = c.GetInputBytes(context.Background(), 1, RealInput)
+			assert.NoError(t, err)
+		}()
+	}
+
+	started.Wait()
+	// give everyone a moment to join the first call before letting it finish
+	time.Sleep(50 * time.Millisecond)
+	close(bs.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), bs.calls.Load())
+
+	results[0][0] = 'X'
+	for _, curr := range results[1:] {
+		assert.Equal(t, "shared", string(curr))
+	}
+}
+
+// cancelableSource is a blockingSource that gives up if its context is done first
+type cancelableSource struct {
+	blockingSource
+}
+
+func (cs *cancelableSource) Input(ctx context.Context, _ int, _ Kind) ([]byte, error) {
+	cs.calls.Add(1)
+	select {
+	case <-cs.release:
+		return []byte("shared"), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func Test_GetInputBytesInflightOutlivesFirstCaller(t *testing.T) {
+	cs := &cancelableSource{blockingSource{release: make(chan struct{})}}
+	c, err := NewClient(WithCacheDir(t.TempDir()), WithSource(cs))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	firstDone := make(chan error)
+	go func() {
+		_, err := c.GetInputBytes(ctx, 1, RealInput)
+		firstDone <- err
+	}()
+
+	// wait for the first caller to start the fetch before anyone else joins it
+	require.Eventually(t, func() bool { return cs.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	secondDone := make(chan []byte)
+	go func() {
+		data, err := c.GetInputBytes(context.Background(), 1, RealInput)
+		assert.NoError(t, err)
+		secondDone <- data
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	// the first caller stops waiting straight away, even though the fetch is still going
+	cancel()
+	assert.ErrorIs(t, <-firstDone, context.Canceled)
+
+	close(cs.release)
+	assert.Equal(t, "shared", string(<-secondDone))
+	assert.Equal(t, int32(1), cs.calls.Load())
+}
+
+func Test_GetInputBytesAbandonedFetchIsForgotten(t *testing.T) {
+	srv := inputtest.NewServer(t, "abc")
+	srv.SetInput(1, []byte("input one\n"))
+	// the first download never finishes
+	srv.Inject(inputtest.Fault{Path: "/puzzle/1/input", Delay: time.Hour, Times: 1})
+
+	c, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithHTTPClient(srv.Client()),
+		WithCacheDir(t.TempDir()),
+		WithTokenProvider(StaticToken("abc")),
+		WithRetryPolicy(NoRetries),
+		WithRateLimit(rate.Inf, 1),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	firstDone := make(chan error)
+	go func() {
+		_, err := c.GetInputBytes(ctx, 1, RealInput)
+		firstDone <- err
+	}()
+
+	require.Eventually(t, func() bool { return srv.Count("/puzzle/1/input") == 1 }, time.Second, time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-firstDone, context.Canceled)
+
+	// nobody is waiting for the stalled download any more, so a new caller gets a new one
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	data, err := c.GetInputBytes(ctx, 1, RealInput)
+	require.NoError(t, err)
+	assert.Equal(t, "input one\n", string(data))
+	assert.Equal(t, 2, srv.Count("/puzzle/1/input"))
+}
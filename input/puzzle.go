@@ -0,0 +1,415 @@
+package input
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// PuzzleInfo is what we can work out about a puzzle from its page on the puzzle site
+type PuzzleInfo struct {
+	Number int
+	Title  string
+
+	// ReleasedAt is the zero time if the page doesn't say when the puzzle came out
+	ReleasedAt time.Time
+
+	Examples []Example
+}
+
+// Example is an example input from a puzzle's statement along with the answer the statement gives for it
+type Example struct {
+	Input  string
+	Answer string
+}
+
+func getPageFile(directory string, num int) string {
+	return filepath.Join(directory, fmt.Sprintf("%02d.puzzle.html", num))
+}
+
+func getPageMetadataFile(directory string, num int) string {
+	return filepath.Join(directory, fmt.Sprintf("%02d.puzzle.meta.json", num))
+}
+
+// Page reads a cached puzzle page. As with inputs, a page that doesn't match its metadata is reported as ErrNoInput
+// (along with why)
+func (fs *FileSource) Page(num int) ([]byte, error) {
+	pageFile := getPageFile(fs.Directory, num)
+
+	data, err := os.ReadFile(pageFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoInput
+	}
+	if err != nil {
+		return nil, fmt.Errorf("downloader: FileSource: could not read cached page: %s: %w", pageFile, err)
+	}
+
+	metaFile := getPageMetadataFile(fs.Directory, num)
+	metaBytes, err := os.ReadFile(metaFile)
+	if err != nil {
+		// pages have always been cached with metadata, so this one is half written
+		return nil, fmt.Errorf("%w: %s: could not read metadata: %w", ErrNoInput, metaFile, err)
+	}
+
+	var meta Metadata
+	err = json.Unmarshal(metaBytes, &meta)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: could not parse metadata: %w", ErrNoInput, metaFile, err)
+	}
+
+	err = meta.checkSum(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrNoInput, pageFile, err)
+	}
+
+	return data, nil
+}
+
+// StorePage caches a puzzle page the same way StoreWithMetadata caches an input
+func (fs *FileSource) StorePage(num int, data []byte, meta Metadata) error {
+	pageFile := getPageFile(fs.Directory, num)
+	metaFile := getPageMetadataFile(fs.Directory, num)
+
+	if err := meta.checkSum(data); err != nil {
+		return fmt.Errorf("downloader: FileSource: refusing to cache page: %s: %w", pageFile, err)
+	}
+
+	metaBytes, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return fmt.Errorf("downloader: FileSource: could not encode metadata: %w", err)
+	}
+
+	err = WriteFileAtomic(metaFile, metaBytes, 0664)
+	if err != nil {
+		return fmt.Errorf("downloader: FileSource: could not write metadata: %s: %w", metaFile, err)
+	}
+
+	err = WriteFileAtomic(pageFile, data, 0664)
+	if err != nil {
+		return fmt.Errorf("downloader: FileSource: could not cache page: %s: %w", pageFile, err)
+	}
+
+	return nil
+}
+
+// Page downloads a puzzle's page. Puzzle pages can be read without logging in, so the session token is only sent if
+// there is one
+func (hs *HTTPSource) Page(ctx context.Context, num int) ([]byte, Metadata, error) {
+	if hs.offline {
+		return nil, Metadata{}, fmt.Errorf("downloader: HTTPSource: page for puzzle %d: %w", num, ErrNotCached)
+	}
+
+	hs.logger.Info("downloading page", "puzzle", num)
+	pageURL := fmt.Sprintf("%s/puzzle/%d/", hs.baseURL, num)
+
+	token, err := hs.token(ctx)
+	if err != nil && !errors.Is(err, ErrNoToken) {
+		return nil, Metadata{}, err
+	}
+
+	resp, err := hs.get(ctx, pageURL, token)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, Metadata{}, newHTTPError(pageURL, resp)
+	}
+
+	meta := NewMetadata(resp.Body)
+	meta.StatusCode = resp.StatusCode
+	meta.ContentType = resp.Header.Get("Content-Type")
+
+	return resp.Body, meta, nil
+}
+
+// GetPuzzle gets the title, release date and examples of a puzzle from its page on the puzzle site. The page is cached
+// next to the inputs, so this only hits the network once per puzzle
+func (c *Client) GetPuzzle(ctx context.Context, num int) (*PuzzleInfo, error) {
+	cache := c.Cache()
+
+	page, err := cache.Page(num)
+	if err == nil {
+		return ParsePuzzlePage(num, page)
+	}
+	if !errors.Is(err, ErrNoInput) {
+		return nil, err
+	}
+	if err != ErrNoInput {
+		c.logger.Warn("ignoring cached page", "puzzle", num, "err", err)
+	}
+
+	page, meta, err := c.httpSource().Page(ctx, num)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse before caching, so a page we can't make sense of (such as a login page) isn't kept around
+	info, err := ParsePuzzlePage(num, page)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cache.StorePage(num, page, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func GetPuzzle(ctx context.Context, num int) (*PuzzleInfo, error) {
+	c, err := defaultClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetPuzzle(ctx, num)
+}
+
+// ParsePuzzlePage pulls what it can out of the HTML of a puzzle's page. Only the title is required, the release date and
+// examples are left empty if they can't be found
+func ParsePuzzlePage(num int, page []byte) (*PuzzleInfo, error) {
+	doc, err := html.Parse(bytes.NewReader(page))
+	if err != nil {
+		return nil, fmt.Errorf("downloader: ParsePuzzlePage: could not parse HTML: %w", err)
+	}
+
+	pageNum, title := findTitle(doc)
+	if title == "" {
+		return nil, fmt.Errorf("downloader: ParsePuzzlePage: puzzle %d: could not find a puzzle title (is this a puzzle page?)", num)
+	}
+	if pageNum != num {
+		return nil, fmt.Errorf("downloader: ParsePuzzlePage: expected the page for puzzle %d, got puzzle %d", num, pageNum)
+	}
+
+	return &PuzzleInfo{
+		Number:     num,
+		Title:      title,
+		ReleasedAt: findReleaseDate(doc),
+		Examples:   findExamples(doc),
+	}, nil
+}
+
+// textContent is all the text inside n, the same as the DOM property of the same name
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	var sb strings.Builder
+	for c := range n.Descendants() {
+		if c.Type == html.TextNode {
+			sb.WriteString(c.Data)
+		}
+	}
+
+	return sb.String()
+}
+
+// collapseSpace squashes runs of whitespace in to single spaces, the way a browser displays text
+func collapseSpace(x string) string {
+	return strings.Join(strings.Fields(x), " ")
+}
+
+var titleRegex = regexp.MustCompile(`(?i)^puzzle\s+(\d+)\s*[:\-–—]\s*(.+?)(?:\s+[|\-–—]\s+i18n.*)?$`)
+
+// findTitle looks for "Puzzle N: Title" in the page's headings, then in its <title>
+func findTitle(doc *html.Node) (int, string) {
+	var fromTitle []string
+	for n := range doc.Descendants() {
+		if n.Type != html.ElementNode {
+			continue
+		}
+
+		switch n.DataAtom {
+		case atom.H1, atom.H2, atom.H3:
+			if num, title, ok := parseTitle(textContent(n)); ok {
+				return num, title
+			}
+		case atom.Title:
+			fromTitle = append(fromTitle, textContent(n))
+		}
+	}
+
+	for _, curr := range fromTitle {
+		if num, title, ok := parseTitle(curr); ok {
+			return num, title
+		}
+	}
+
+	return 0, ""
+}
+
+func parseTitle(x string) (int, string, bool) {
+	m := titleRegex.FindStringSubmatch(collapseSpace(x))
+	if m == nil {
+		return 0, "", false
+	}
+
+	num, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, "", false
+	}
+
+	return num, m[2], true
+}
+
+var (
+	releaseDateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+	releaseDateRegex   = regexp.MustCompile(`(?i)releas\w*[^\d.]{0,20}?(\d{4}-\d{2}-\d{2}(?:[T ]\d{2}:\d{2}(?::\d{2})?(?:Z|[+-]\d{2}:?\d{2})?)?)`)
+)
+
+// findReleaseDate uses the first <time datetime="..."> on the page, or failing that an ISO 8601 date shortly after the
+// word "released". Times without a zone are taken to be UTC
+func findReleaseDate(doc *html.Node) time.Time {
+	for n := range doc.Descendants() {
+		if n.Type == html.ElementNode && n.DataAtom == atom.Time {
+			for _, attr := range n.Attr {
+				if attr.Key == "datetime" {
+					if t, ok := parseReleaseDate(attr.Val); ok {
+						return t
+					}
+				}
+			}
+		}
+	}
+
+	if m := releaseDateRegex.FindStringSubmatch(collapseSpace(textContent(doc))); m != nil {
+		if t, ok := parseReleaseDate(m[1]); ok {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func parseReleaseDate(x string) (time.Time, bool) {
+	for _, layout := range releaseDateLayouts {
+		t, err := time.Parse(layout, strings.TrimSpace(x))
+		if err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// segment is a piece of the page's text, in document order
+type segment struct {
+	text string
+
+	// pre is set for a whole <pre> block, which is where example inputs live
+	pre bool
+
+	// emphasized is set for a whole <code>, <strong> or similar, which is how answers tend to be called out
+	emphasized bool
+}
+
+func flatten(n *html.Node, out []segment) []segment {
+	switch n.Type {
+	case html.TextNode:
+		return append(out, segment{text: n.Data})
+	case html.ElementNode:
+		switch n.DataAtom {
+		case atom.Head, atom.Script, atom.Style:
+			return out
+		case atom.Pre:
+			return append(out, segment{text: textContent(n), pre: true})
+		case atom.Code, atom.Strong, atom.B, atom.Em, atom.Kbd, atom.Samp:
+			return append(out, segment{text: textContent(n), emphasized: true})
+		}
+	}
+
+	for c := range n.ChildNodes() {
+		out = flatten(c, out)
+	}
+
+	return out
+}
+
+// findExamples pairs each <pre> block with an "the answer is ..." that follows it (before the next <pre>). A <pre> with
+// no answer after it is an illustration rather than an example, and is skipped
+func findExamples(doc *html.Node) []Example {
+	segments := flatten(doc, nil)
+
+	var ret []Example
+	for i, curr := range segments {
+		if !curr.pre {
+			continue
+		}
+
+		end := i + 1
+		for end < len(segments) && !segments[end].pre {
+			end++
+		}
+
+		if ans, ok := findAnswer(segments[i+1 : end]); ok {
+			ret = append(ret, Example{
+				Input:  strings.TrimRight(curr.text, "\n") + "\n",
+				Answer: ans,
+			})
+		}
+	}
+
+	return ret
+}
+
+var answerRegex = regexp.MustCompile(`(?i)\banswer\b[^.!?\n]{0,60}?\b(?:is|would be|should be|will be)\s*:?\s*`)
+
+// findAnswer finds the answer in a statement like "the answer is <code>42</code>" or "the answer would be 42."
+func findAnswer(segments []segment) (string, bool) {
+	var sb strings.Builder
+	starts := make([]int, len(segments))
+	for i, curr := range segments {
+		starts[i] = sb.Len()
+		sb.WriteString(curr.text)
+	}
+	text := sb.String()
+
+	loc := answerRegex.FindStringIndex(text)
+	if loc == nil {
+		return "", false
+	}
+
+	// an emphasized answer is used as is, since it can contain spaces and punctuation
+	for i, curr := range segments {
+		end := starts[i] + len(curr.text)
+		if end <= loc[1] {
+			continue
+		}
+
+		// the match can run in to the leading space of the emphasized part, or stop short of it
+		between := text[min(starts[i], loc[1]):max(starts[i], loc[1])]
+		if curr.emphasized && strings.TrimSpace(between) == "" {
+			if ans := strings.TrimSpace(curr.text); ans != "" {
+				return ans, true
+			}
+		}
+		break
+	}
+
+	// otherwise the answer is the next word, minus the punctuation around it
+	rest := strings.Fields(text[loc[1]:])
+	if len(rest) == 0 {
+		return "", false
+	}
+	ans := strings.TrimRight(strings.TrimLeft(rest[0], "\"'`(“‘"), ".,;:!?\"'`)”’")
+	if ans == "" {
+		return "", false
+	}
+
+	return ans, true
+}
@@ -0,0 +1,135 @@
+package input
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input/inputtest"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	return b
+}
+
+func Test_ParsePuzzlePage(t *testing.T) {
+	t.Run("time element and code answer", func(t *testing.T) {
+		info, err := ParsePuzzlePage(1, readFixture(t, "puzzle01.html"))
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, info.Number)
+		assert.Equal(t, "Length limits on messaging platforms", info.Title)
+		assert.True(t, time.Date(2025, time.March, 7, 0, 0, 0, 0, time.UTC).Equal(info.ReleasedAt))
+		assert.Equal(t, []Example{
+			{
+				Input:  "a message that contains ünïcödé characters\nplain old ASCII & friends\n",
+				Answer: "31",
+			},
+		}, info.Examples)
+	})
+
+	t.Run("date in text and plain answers", func(t *testing.T) {
+		info, err := ParsePuzzlePage(7, readFixture(t, "puzzle07.html"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "The audit trail fixer", info.Title)
+		assert.True(t, time.Date(2025, time.March, 13, 0, 0, 0, 0, time.UTC).Equal(info.ReleasedAt))
+		require.Len(t, info.Examples, 2)
+		assert.Equal(t, "2012-11-05T09:39:00.000-04:00\t969\t3358\n2012-05-27T17:38:00.000-04:00\t2160\t2118\n", info.Examples[0].Input)
+		assert.Equal(t, "866", info.Examples[0].Answer)
+		assert.Equal(t, "2020-01-01T00:00:00.000+00:00\t1\t1\n", info.Examples[1].Input)
+		assert.Equal(t, "three hours", info.Examples[1].Answer)
+	})
+
+	t.Run("wrong puzzle", func(t *testing.T) {
+		_, err := ParsePuzzlePage(2, readFixture(t, "puzzle01.html"))
+		assert.ErrorContains(t, err, "got puzzle 1")
+	})
+
+	t.Run("not a puzzle page", func(t *testing.T) {
+		_, err := ParsePuzzlePage(1, readFixture(t, "login.html"))
+		assert.ErrorContains(t, err, "could not find a puzzle title")
+	})
+}
+
+func Test_findAnswer(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		segments []segment
+		expected string
+		found    bool
+	}{
+		{name: "plain", segments: []segment{{text: "so the answer is 42."}}, expected: "42", found: true},
+		{name: "quoted", segments: []segment{{text: `The answer would be "abc", not "def"`}}, expected: "abc", found: true},
+		{name: "emphasized", segments: []segment{{text: "the answer is: "}, {text: "12:34 UTC", emphasized: true}, {text: "."}}, expected: "12:34 UTC", found: true},
+		{name: "emphasis elsewhere", segments: []segment{{text: "the answer is 7 and "}, {text: "not this", emphasized: true}}, expected: "7", found: true},
+		{name: "no answer", segments: []segment{{text: "the answer is left as an exercise"}}, expected: "left", found: true},
+		{name: "answer in another sentence", segments: []segment{{text: "Find the answer. It is 12."}}},
+		{name: "nothing", segments: []segment{{text: "some text"}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ans, ok := findAnswer(tc.segments)
+			assert.Equal(t, tc.found, ok)
+			assert.Equal(t, tc.expected, ans)
+		})
+	}
+}
+
+func Test_GetPuzzle(t *testing.T) {
+	page := readFixture(t, "puzzle01.html")
+
+	srv := inputtest.NewServer(t, "secret")
+	srv.SetPage(1, page)
+	srv.SetPage(2, readFixture(t, "login.html"))
+
+	dir := t.TempDir()
+	c, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithHTTPClient(srv.Client()),
+		WithCacheDir(dir),
+		WithRetryPolicy(NoRetries),
+		WithTokenProvider(func(_ context.Context) (string, error) {
+			return "", ErrNoToken
+		}),
+	)
+	require.NoError(t, err)
+
+	info, err := c.GetPuzzle(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Length limits on messaging platforms", info.Title)
+	assert.FileExists(t, filepath.Join(dir, "01.puzzle.html"))
+	assert.FileExists(t, filepath.Join(dir, "01.puzzle.meta.json"))
+
+	// second time comes from the cache
+	_, err = c.GetPuzzle(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Len(t, srv.Requests(), 1)
+
+	// a page that doesn't match its metadata is downloaded again
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "01.puzzle.html"), []byte("<html></html>"), 0664))
+	_, err = c.GetPuzzle(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Len(t, srv.Requests(), 2)
+
+	// pages that can't be parsed aren't cached
+	_, err = c.GetPuzzle(context.Background(), 2)
+	assert.Error(t, err)
+	assert.NoFileExists(t, filepath.Join(dir, "02.puzzle.html"))
+
+	_, err = c.GetPuzzle(context.Background(), 3)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	for _, curr := range srv.Requests() {
+		assert.Empty(t, curr.Token, "no token, so no cookie")
+	}
+}
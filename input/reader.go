@@ -0,0 +1,239 @@
+package input
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/transform"
+)
+
+type readOptions struct {
+	charset           string
+	encoding          encoding.Encoding
+	normalizeNewlines bool
+}
+
+// ReadOption changes how NewReader (and GetInputReader) turn raw input in to text
+type ReadOption func(o *readOptions)
+
+// WithCharset decodes input from a named character set (anything IANA has a name for, such as "cp437", "latin1" or
+// "shift_jis") in to UTF-8
+func WithCharset(name string) ReadOption {
+	return func(o *readOptions) {
+		o.charset = name
+	}
+}
+
+// WithEncoding decodes input using any encoding from golang.org/x/text/encoding (or its subpackages) in to UTF-8
+func WithEncoding(e encoding.Encoding) ReadOption {
+	return func(o *readOptions) {
+		o.encoding = e
+	}
+}
+
+// WithNormalizedNewlines turns Windows (CRLF) and classic Mac (CR) line endings in to plain LF
+func WithNormalizedNewlines() ReadOption {
+	return func(o *readOptions) {
+		o.normalizeNewlines = true
+	}
+}
+
+// NewReader wraps r so that reading from it decodes and normalizes the input as asked for by opts. With no options,
+// the input is passed through untouched
+func NewReader(r io.Reader, opts ...ReadOption) (io.Reader, error) {
+	o := &readOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.charset != "" {
+		if o.encoding != nil {
+			return nil, fmt.Errorf("downloader: NewReader: only one of WithCharset and WithEncoding can be used")
+		}
+
+		e, err := ianaindex.IANA.Encoding(o.charset)
+		if err != nil {
+			return nil, fmt.Errorf("downloader: NewReader: unknown charset: %s: %w", o.charset, err)
+		}
+		if e == nil {
+			return nil, fmt.Errorf("downloader: NewReader: charset is not supported: %s", o.charset)
+		}
+		o.encoding = e
+	}
+
+	if o.encoding != nil {
+		r = transform.NewReader(r, o.encoding.NewDecoder())
+	}
+
+	if o.normalizeNewlines {
+		r = transform.NewReader(r, newlineNormalizer{})
+	}
+
+	return r, nil
+}
+
+// newlineNormalizer is a transform.Transformer that turns CRLF and lone CRs in to LF
+type newlineNormalizer struct {
+	transform.NopResetter
+}
+
+func (newlineNormalizer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if nDst >= len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+
+		c := src[nSrc]
+		if c != '\r' {
+			dst[nDst] = c
+			nDst++
+			nSrc++
+			continue
+		}
+
+		// need to see what's after the CR before we know what to do with it
+		if nSrc+1 >= len(src) && !atEOF {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		dst[nDst] = '\n'
+		nDst++
+		nSrc++
+		if nSrc < len(src) && src[nSrc] == '\n' {
+			nSrc++
+		}
+	}
+
+	return nDst, nSrc, nil
+}
+
+// GetInputReader gets a puzzle's input, decoded and normalized according to opts as it is read. An input that is
+// already in the cache is streamed straight from disk, so it never has to fit in memory all at once, and is checked
+// against its metadata along the way: a damaged file ends with an error wrapping ErrChecksumMismatch rather than
+// io.EOF. Anything else is fetched (and cached) the same way as GetInputBytes first
+func (c *Client) GetInputReader(ctx context.Context, num int, k Kind, opts ...ReadOption) (io.ReadCloser, error) {
+	var raw io.ReadCloser
+	if fs := cacheOf(c.Source); fs != nil {
+		f, err := fs.Open(num, k)
+		if err != nil && !errors.Is(err, ErrNoInput) {
+			return nil, err
+		}
+		raw = f
+	}
+
+	if raw == nil {
+		data, err := c.GetInputBytes(ctx, num, k)
+		if err != nil {
+			return nil, err
+		}
+		raw = io.NopCloser(bytes.NewReader(data))
+	}
+
+	r, err := NewReader(raw, opts...)
+	if err != nil {
+		raw.Close()
+		return nil, err
+	}
+
+	return readCloser{Reader: r, Closer: raw}, nil
+}
+
+// cacheOf finds the on-disk cache a source reads from first, if it has one: the source itself, or the first source in
+// a chain (which is how NewClient sets things up)
+func cacheOf(s Source) *FileSource {
+	if cs, ok := s.(ChainSource); ok && len(cs) > 0 {
+		s = cs[0]
+	}
+
+	fs, _ := s.(*FileSource)
+	return fs
+}
+
+// readCloser reads from a decoding reader, but closes what's underneath it
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// LineReader reads an input one line at a time. Like bufio.Scanner, any error stops iteration and is available from
+// Err afterward. Unlike bufio.Scanner, there is no limit on how long a line can be
+type LineReader struct {
+	r      *bufio.Reader
+	closer io.Closer
+	err    error
+}
+
+// NewLineReader reads lines from r. If r is an io.Closer, closing the LineReader closes it too
+func NewLineReader(r io.Reader) *LineReader {
+	lr := &LineReader{
+		r: bufio.NewReader(r),
+	}
+	lr.closer, _ = r.(io.Closer)
+
+	return lr
+}
+
+// All yields each line without its trailing "\n". Lines are split the same way as SplitLines, so a newline at the very
+// end of the input doesn't produce an extra empty line. Only use WithNormalizedNewlines if you want "\r"s gone as well
+func (lr *LineReader) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for {
+			line, err := lr.r.ReadString('\n')
+			if errors.Is(err, io.EOF) {
+				if line != "" {
+					yield(line)
+				}
+				return
+			}
+			if err != nil {
+				lr.err = fmt.Errorf("downloader: LineReader: could not read line: %w", err)
+				return
+			}
+
+			if !yield(strings.TrimSuffix(line, "\n")) {
+				return
+			}
+		}
+	}
+}
+
+// Err is the error that stopped All, if any
+func (lr *LineReader) Err() error {
+	return lr.err
+}
+
+// Close closes the reader the lines come from, if it needs closing
+func (lr *LineReader) Close() error {
+	if lr.closer == nil {
+		return nil
+	}
+
+	return lr.closer.Close()
+}
+
+// GetInputLineReader is GetInputReader, but read one line at a time. Close it when done
+func (c *Client) GetInputLineReader(ctx context.Context, num int, k Kind, opts ...ReadOption) (*LineReader, error) {
+	r, err := c.GetInputReader(ctx, num, k, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLineReader(r), nil
+}
+
+func GetInputReader(ctx context.Context, num int, k Kind, opts ...ReadOption) (io.ReadCloser, error) {
+	c, err := defaultClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetInputReader(ctx, num, k, opts...)
+}
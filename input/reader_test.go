@@ -0,0 +1,179 @@
+package input
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/transform"
+)
+
+func readAll(t *testing.T, r io.Reader, err error) string {
+	t.Helper()
+	require.NoError(t, err)
+
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return string(b)
+}
+
+func Test_NewReader(t *testing.T) {
+	t.Run("no options passes through", func(t *testing.T) {
+		r, err := NewReader(strings.NewReader("a\r\nb"))
+		assert.Equal(t, "a\r\nb", readAll(t, r, err))
+	})
+
+	t.Run("named charset", func(t *testing.T) {
+		// ║ and ╗ in CP437
+		r, err := NewReader(strings.NewReader("\xba\xbb"), WithCharset("cp437"))
+		assert.Equal(t, "║╗", readAll(t, r, err))
+
+		r, err = NewReader(strings.NewReader("caf\xe9"), WithCharset("latin1"))
+		assert.Equal(t, "café", readAll(t, r, err))
+	})
+
+	t.Run("encoding", func(t *testing.T) {
+		r, err := NewReader(strings.NewReader("\xba"), WithEncoding(charmap.CodePage437))
+		assert.Equal(t, "║", readAll(t, r, err))
+	})
+
+	t.Run("unknown charset", func(t *testing.T) {
+		_, err := NewReader(strings.NewReader(""), WithCharset("klingon"))
+		assert.Error(t, err)
+	})
+
+	t.Run("charset and encoding together", func(t *testing.T) {
+		_, err := NewReader(strings.NewReader(""), WithCharset("cp437"), WithEncoding(charmap.CodePage437))
+		assert.Error(t, err)
+	})
+
+	t.Run("newlines", func(t *testing.T) {
+		r, err := NewReader(strings.NewReader("dos\r\nmac\runix\n\r\n\r"), WithNormalizedNewlines())
+		assert.Equal(t, "dos\nmac\nunix\n\n\n", readAll(t, r, err))
+
+		// CRLF split across reads must still become a single LF
+		r, err = NewReader(iotest.OneByteReader(strings.NewReader("a\r\nb\r\n")), WithNormalizedNewlines())
+		assert.Equal(t, "a\nb\n", readAll(t, r, err))
+	})
+
+	t.Run("decode then normalize", func(t *testing.T) {
+		r, err := NewReader(strings.NewReader("\xba\r\n\xbb\r\n"), WithCharset("cp437"), WithNormalizedNewlines())
+		assert.Equal(t, "║\n╗\n", readAll(t, r, err))
+	})
+}
+
+func Test_newlineNormalizer(t *testing.T) {
+	s, _, err := transform.String(newlineNormalizer{}, strings.Repeat("x\r\n", 10_000))
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("x\n", 10_000), s)
+}
+
+func Test_LineReader(t *testing.T) {
+	tests := []string{
+		"",
+		"one",
+		"one\ntwo",
+		"one\ntwo\n",
+		"one\n\nthree\n",
+		"\n",
+		strings.Repeat("long", 100_000) + "\nshort",
+	}
+
+	for _, curr := range tests {
+		lr := NewLineReader(strings.NewReader(curr))
+		lines := slices.Collect(lr.All())
+		assert.NoError(t, lr.Err())
+		// slices.Equal rather than assert.Equal, since an empty input gives nil here and an empty slice from SplitLines
+		assert.True(t, slices.Equal(SplitLines(curr), lines), "%q", curr)
+	}
+
+	t.Run("stopping early", func(t *testing.T) {
+		lr := NewLineReader(strings.NewReader("a\nb\nc\n"))
+		var seen []string
+		for line := range lr.All() {
+			seen = append(seen, line)
+			if line == "b" {
+				break
+			}
+		}
+		assert.Equal(t, []string{"a", "b"}, seen)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		boom := errors.New("boom")
+		lr := NewLineReader(io.MultiReader(strings.NewReader("a\nb"), iotest.ErrReader(boom)))
+		lines := slices.Collect(lr.All())
+		assert.ErrorIs(t, lr.Err(), boom)
+		assert.Equal(t, []string{"a"}, lines)
+	})
+}
+
+func Test_GetInputReader(t *testing.T) {
+	ms := NewMemorySource()
+	ms.Set(16, TestInput, []byte("\xba\xbb\r\n\xc8\xbc\r\n"))
+
+	c, err := NewClient(WithCacheDir(t.TempDir()), WithSource(ms))
+	require.NoError(t, err)
+
+	r, err := c.GetInputReader(context.Background(), 16, TestInput, WithCharset("cp437"), WithNormalizedNewlines())
+	assert.Equal(t, "║╗\n╚╝\n", readAll(t, r, err))
+	assert.NoError(t, r.Close())
+
+	lr, err := c.GetInputLineReader(context.Background(), 16, TestInput, WithCharset("cp437"), WithNormalizedNewlines())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"║╗", "╚╝"}, slices.Collect(lr.All()))
+	assert.NoError(t, lr.Err())
+	assert.NoError(t, lr.Close())
+
+	_, err = c.GetInputReader(context.Background(), 17, TestInput)
+	assert.ErrorIs(t, err, ErrNoInput)
+}
+
+func Test_GetInputReaderFromCache(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewClient(WithCacheDir(dir), WithOffline(true))
+	require.NoError(t, err)
+	require.NoError(t, c.Cache().Store(16, TestInput, []byte("\xba\xbb\r\n\xc8\xbc\r\n")))
+
+	t.Run("streamed from the cache", func(t *testing.T) {
+		r, err := c.GetInputReader(context.Background(), 16, TestInput, WithCharset("cp437"), WithNormalizedNewlines())
+		assert.Equal(t, "║╗\n╚╝\n", readAll(t, r, err))
+		assert.NoError(t, r.Close())
+	})
+
+	t.Run("damaged after it was opened", func(t *testing.T) {
+		r, err := c.GetInputReader(context.Background(), 16, TestInput)
+		require.NoError(t, err)
+		defer r.Close()
+
+		// same size, different bytes, so it can only be caught once it has all been read
+		require.NoError(t, os.WriteFile(getInputFile(dir, 16, TestInput), []byte("\xba\xbb\r\n\xc8\xbd\r\n"), 0664))
+
+		_, err = io.ReadAll(r)
+		assert.ErrorIs(t, err, ErrChecksumMismatch)
+
+		lr, err := c.GetInputLineReader(context.Background(), 16, TestInput)
+		require.NoError(t, err)
+		defer lr.Close()
+		for range lr.All() {
+		}
+		assert.ErrorIs(t, lr.Err(), ErrChecksumMismatch)
+	})
+
+	t.Run("damaged before it was opened", func(t *testing.T) {
+		// the wrong size is a cache miss, which has to be downloaded again, and this client is offline
+		require.NoError(t, os.WriteFile(getInputFile(dir, 16, TestInput), []byte("short"), 0664))
+
+		_, err := c.GetInputReader(context.Background(), 16, TestInput)
+		assert.ErrorIs(t, err, ErrNotCached)
+	})
+}
@@ -0,0 +1,152 @@
+package input
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RetryPolicy controls how hard we try to download an input when the server is having a bad time. Server errors (5xx),
+// 429 Too Many Requests and network errors are retried, everything else is returned straight away
+type RetryPolicy struct {
+	// MaxAttempts is the most requests made for one input, including the first. Anything below 2 means no retries
+	MaxAttempts int
+
+	// BaseDelay is the wait before the first retry. It doubles for every retry after that
+	BaseDelay time.Duration
+
+	// MaxDelay caps the wait between attempts. If the server asks us (with Retry-After) to wait longer than this, we
+	// give up instead
+	MaxDelay time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+// NoRetries makes exactly one request
+var NoRetries = RetryPolicy{MaxAttempts: 1}
+
+// DefaultRateLimit is how quickly a Client will make requests to the puzzle site unless told otherwise. It is shared by
+// everything using the same Client, so fetching every input at once is still polite
+const (
+	DefaultRateLimit = rate.Limit(4)
+	DefaultRateBurst = 4
+)
+
+// backoff is how long to wait before retry number attempt (starting at 1). The delay doubles each time and "full
+// jitter" is applied (the wait is anywhere from zero up to the delay), so a bunch of clients that all failed at once
+// don't all come back at the same moment either
+func (rp RetryPolicy) backoff(attempt int) time.Duration {
+	delay := rp.BaseDelay << (attempt - 1)
+	if delay <= 0 || (rp.MaxDelay > 0 && delay > rp.MaxDelay) {
+		// the shift can overflow if there are lots of attempts
+		delay = rp.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	return rand.N(delay + 1)
+}
+
+func retryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// response is everything we need from an HTTP response once its body has been read and closed
+type response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// doWithRetries makes a request (built fresh each time by newRequest) until it gets a response that shouldn't be
+// retried or runs out of attempts. Every attempt waits on limiter first, if there is one. The last response is
+// returned even if it wasn't successful, leaving it to the caller to decide what a bad status means
+func doWithRetries(ctx context.Context, hc *http.Client, limiter *rate.Limiter, policy RetryPolicy, newRequest func() (*http.Request, error)) (*response, error) {
+	attempts := max(policy.MaxAttempts, 1)
+
+	for attempt := 1; ; attempt++ {
+		if limiter != nil {
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("downloader: doWithRetries: waiting for rate limiter: %w", err)
+			}
+		}
+
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		var res *response
+		resp, err := hc.Do(req)
+		if err == nil {
+			var body []byte
+			body, err = io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				err = fmt.Errorf("could not read HTTP response body: %w", err)
+			} else {
+				res = &response{
+					StatusCode: resp.StatusCode,
+					Header:     resp.Header,
+					Body:       body,
+				}
+			}
+		} else {
+			err = fmt.Errorf("could not make HTTP request: %w", err)
+		}
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+
+		if res != nil && !retryable(res.StatusCode) {
+			return res, nil
+		}
+
+		if attempt >= attempts {
+			if err != nil {
+				return nil, fmt.Errorf("downloader: doWithRetries: giving up after %d attempts: %w", attempt, err)
+			}
+			return res, nil
+		}
+
+		delay := policy.backoff(attempt)
+		if res != nil {
+			if retryAfter := parseRetryAfter(res.Header.Get("Retry-After")); retryAfter > 0 {
+				if policy.MaxDelay > 0 && retryAfter > policy.MaxDelay {
+					// not going to wait that long, so let the caller see the response
+					return res, nil
+				}
+				delay = retryAfter
+			}
+		}
+
+		err = sleep(ctx, delay)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sleep waits for d, or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
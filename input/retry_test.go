@@ -0,0 +1,173 @@
+package input
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input/inputtest"
+	"golang.org/x/time/rate"
+)
+
+// newFlakyServer fails the first failures requests for each input with status (setting Retry-After if given) and then
+// serves the input. Puzzles 1 to 5 have inputs
+func newFlakyServer(t *testing.T, failures int, status int, retryAfter string) *inputtest.Server {
+	srv := inputtest.NewServer(t, "secret")
+	for i := 1; i <= 5; i++ {
+		srv.SetInput(i, []byte(fmt.Sprintf("input for /puzzle/%d/input", i)))
+		srv.SetTestInput(i, []byte(fmt.Sprintf("input for /puzzle/%d/test-input", i)))
+	}
+
+	if failures > 0 {
+		srv.Inject(inputtest.Fault{Status: status, Body: "try again later", RetryAfter: retryAfter, Times: failures})
+	}
+
+	return srv
+}
+
+func newRetryClient(t *testing.T, srv *inputtest.Server, opts ...Option) *Client {
+	opts = append([]Option{
+		WithBaseURL(srv.URL),
+		WithCacheDir(t.TempDir()),
+		WithTokenProvider(StaticToken("secret")),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Second}),
+		WithRateLimit(rate.Inf, 1),
+	}, opts...)
+
+	c, err := NewClient(opts...)
+	require.NoError(t, err)
+
+	return c
+}
+
+func Test_Retries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("server errors are retried", func(t *testing.T) {
+		srv := newFlakyServer(t, 2, http.StatusServiceUnavailable, "")
+		c := newRetryClient(t, srv)
+
+		data, err := c.GetInputBytes(ctx, 1, RealInput)
+		assert.NoError(t, err)
+		assert.Equal(t, "input for /puzzle/1/input", string(data))
+		assert.Len(t, srv.Requests(), 3)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		srv := newFlakyServer(t, 10, http.StatusBadGateway, "")
+		c := newRetryClient(t, srv)
+
+		_, err := c.GetInputBytes(ctx, 1, RealInput)
+		assert.Error(t, err)
+		assert.Len(t, srv.Requests(), 3)
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		srv := newFlakyServer(t, 10, http.StatusNotFound, "")
+		c := newRetryClient(t, srv)
+
+		_, err := c.GetInputBytes(ctx, 1, RealInput)
+		assert.Error(t, err)
+		assert.Len(t, srv.Requests(), 1)
+	})
+
+	t.Run("no retries", func(t *testing.T) {
+		srv := newFlakyServer(t, 1, http.StatusInternalServerError, "")
+		c := newRetryClient(t, srv, WithRetryPolicy(NoRetries))
+
+		_, err := c.GetInputBytes(ctx, 1, RealInput)
+		assert.Error(t, err)
+		assert.Len(t, srv.Requests(), 1)
+	})
+
+	t.Run("Retry-After is honored", func(t *testing.T) {
+		srv := newFlakyServer(t, 1, http.StatusTooManyRequests, "1")
+		c := newRetryClient(t, srv)
+
+		start := time.Now()
+		_, err := c.GetInputBytes(ctx, 1, RealInput)
+		assert.NoError(t, err)
+		assert.Len(t, srv.Requests(), 2)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Retry-After longer than the max delay gives up", func(t *testing.T) {
+		srv := newFlakyServer(t, 1, http.StatusTooManyRequests, "3600")
+		c := newRetryClient(t, srv)
+
+		start := time.Now()
+		_, err := c.GetInputBytes(ctx, 1, RealInput)
+		assert.Error(t, err)
+		assert.Len(t, srv.Requests(), 1)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("context cancellation stops waiting", func(t *testing.T) {
+		srv := newFlakyServer(t, 1, http.StatusServiceUnavailable, "4")
+		c := newRetryClient(t, srv)
+
+		cctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := c.GetInputBytes(cctx, 1, RealInput)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func Test_RateLimitIsShared(t *testing.T) {
+	srv := newFlakyServer(t, 0, http.StatusOK, "")
+	c := newRetryClient(t, srv, WithRateLimit(rate.Every(50*time.Millisecond), 1))
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetInputBytes(context.Background(), i, TestInput)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, srv.Requests(), 5)
+	// the first request goes straight away, the other four have to wait their turn
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
+func Test_Backoff(t *testing.T) {
+	rp := RetryPolicy{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	var longest time.Duration
+	for range 100 {
+		d := rp.backoff(1)
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.LessOrEqual(t, d, 100*time.Millisecond)
+
+		d = rp.backoff(3)
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.LessOrEqual(t, d, 400*time.Millisecond)
+
+		// capped
+		d = rp.backoff(9)
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.LessOrEqual(t, d, time.Second)
+		longest = max(longest, d)
+
+		// overflowing shift is capped too
+		assert.LessOrEqual(t, rp.backoff(80), time.Second)
+	}
+
+	// full jitter can wait less than half the delay (equal jitter never would), but over 100 tries it should use most
+	// of the range
+	assert.Greater(t, longest, 500*time.Millisecond)
+
+	assert.Equal(t, time.Duration(0), NoRetries.backoff(1))
+}
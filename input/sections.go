@@ -0,0 +1,72 @@
+package input
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Section is a run of lines from an input that has several parts separated by blank lines
+type Section struct {
+	// StartLine is the 1-based line number of the section's first line in the whole input
+	StartLine int
+
+	Lines []string
+}
+
+// LineNumber is the line number in the whole input of Lines[i], for error messages
+func (s Section) LineNumber(i int) int {
+	return s.StartLine + i
+}
+
+// Sections splits input in to blank line separated sections. Line endings may be LF, CRLF or CR. Lines that are
+// entirely whitespace count as blank, runs of blank lines are the same as a single one, and blank lines at the start
+// or end of the input are ignored, so a stray trailing newline doesn't produce an empty section. Whitespace within
+// non-blank lines is left alone
+func Sections(in string) []Section {
+	in = strings.ReplaceAll(in, "\r\n", "\n")
+	in = strings.ReplaceAll(in, "\r", "\n")
+
+	var ret []Section
+	var curr *Section
+
+	for i, line := range strings.Split(in, "\n") {
+		if strings.TrimSpace(line) == "" {
+			curr = nil
+			continue
+		}
+
+		if curr == nil {
+			ret = append(ret, Section{StartLine: i + 1})
+			curr = &ret[len(ret)-1]
+		}
+		curr.Lines = append(curr.Lines, line)
+	}
+
+	return ret
+}
+
+// SectionsN is Sections, but it is an error if there aren't exactly n sections
+func SectionsN(in string, n int) ([]Section, error) {
+	ret := Sections(in)
+	if len(ret) != n {
+		return nil, fmt.Errorf("downloader: SectionsN: expected %d sections separated by a blank line, found %d", n, len(ret))
+	}
+
+	return ret, nil
+}
+
+// Fields splits a sep separated record in to its fields. If n is not negative, it is an error for there to be anything
+// other than n fields
+func Fields(record string, sep string, n int) ([]string, error) {
+	ret := strings.Split(record, sep)
+	if n >= 0 && len(ret) != n {
+		return nil, fmt.Errorf("downloader: Fields: expected %d fields separated by %q, found %d", n, sep, len(ret))
+	}
+
+	return ret, nil
+}
+
+// TabFields is Fields for tab separated records
+func TabFields(record string, n int) ([]string, error) {
+	return Fields(record, "\t", n)
+}
@@ -0,0 +1,77 @@
+package input
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Sections(t *testing.T) {
+	t.Run("simple", func(t *testing.T) {
+		s := Sections("a\nb\n\nc\n")
+		require.Len(t, s, 2)
+		assert.Equal(t, Section{StartLine: 1, Lines: []string{"a", "b"}}, s[0])
+		assert.Equal(t, Section{StartLine: 4, Lines: []string{"c"}}, s[1])
+		assert.Equal(t, 5, s[1].LineNumber(1))
+	})
+
+	t.Run("windows line endings", func(t *testing.T) {
+		s := Sections("a\r\nb\r\n\r\nc\r\n")
+		require.Len(t, s, 2)
+		assert.Equal(t, []string{"a", "b"}, s[0].Lines)
+		assert.Equal(t, []string{"c"}, s[1].Lines)
+		assert.Equal(t, 4, s[1].StartLine)
+	})
+
+	t.Run("old mac line endings", func(t *testing.T) {
+		s := Sections("a\r\rb")
+		require.Len(t, s, 2)
+		assert.Equal(t, []string{"b"}, s[1].Lines)
+		assert.Equal(t, 3, s[1].StartLine)
+	})
+
+	t.Run("whitespace only lines and extra blank lines", func(t *testing.T) {
+		s := Sections("\n\na\n \t \n\n\n  b  \n\n\n")
+		require.Len(t, s, 2)
+		assert.Equal(t, Section{StartLine: 3, Lines: []string{"a"}}, s[0])
+		assert.Equal(t, Section{StartLine: 7, Lines: []string{"  b  "}}, s[1])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, Sections(""))
+		assert.Empty(t, Sections("\n \n"))
+	})
+}
+
+func Test_SectionsN(t *testing.T) {
+	s, err := SectionsN("a\n\nb", 2)
+	require.NoError(t, err)
+	assert.Len(t, s, 2)
+
+	_, err = SectionsN("a\nb", 2)
+	assert.ErrorContains(t, err, "expected 2 sections")
+
+	_, err = SectionsN("a\n\nb\n\nc", 2)
+	assert.ErrorContains(t, err, "found 3")
+}
+
+func Test_Fields(t *testing.T) {
+	f, err := Fields("a; b", "; ", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, f)
+
+	_, err = Fields("a; b; c", "; ", 2)
+	assert.Error(t, err)
+
+	f, err = Fields("a b c", " ", -1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, f)
+
+	f, err = TabFields("a\t\tc", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "", "c"}, f)
+
+	_, err = TabFields("a b c", 3)
+	assert.ErrorContains(t, err, `separated by "\t"`)
+}
@@ -0,0 +1,271 @@
+package input
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// ErrNoInput is returned by a Source that does not have the requested input. ChainSource uses this to decide whether
+// it should move on to the next Source in the chain
+var ErrNoInput = errors.New("downloader: input not available from source")
+
+// Source is anything that can produce the raw bytes of a puzzle's input
+type Source interface {
+	Input(ctx context.Context, num int, kind Kind) ([]byte, error)
+}
+
+// Storer is implemented by sources that can keep a copy of input fetched from somewhere else (such as the on-disk
+// cache)
+type Storer interface {
+	Store(num int, kind Kind, data []byte) error
+}
+
+// FileSource reads inputs from a directory laid out the same way as ~/.i18n-puzzles. Each input has a metadata file
+// next to it (see Metadata), and an input that doesn't match its metadata is treated as not being cached at all
+type FileSource struct {
+	Directory string
+
+	// Logger gets told about inputs being cached or ignored. It can be nil
+	Logger *slog.Logger
+}
+
+func (fs *FileSource) logger() *slog.Logger {
+	if fs.Logger == nil {
+		return discardLogger
+	}
+
+	return fs.Logger
+}
+
+func (fs *FileSource) Input(ctx context.Context, num int, kind Kind) ([]byte, error) {
+	data, _, err := fs.InputWithMetadata(ctx, num, kind)
+	return data, err
+}
+
+func (fs *FileSource) InputWithMetadata(_ context.Context, num int, kind Kind) ([]byte, Metadata, error) {
+	data, meta, err := fs.read(num, kind)
+	if err != nil {
+		if errors.Is(err, ErrNoInput) && err != ErrNoInput {
+			// there is something cached, but it's bad
+			fs.logger().Warn("ignoring cached input", "puzzle", num, "kind", kind, "err", err)
+		}
+		return nil, Metadata{}, err
+	}
+
+	if meta == nil {
+		return data, NewMetadata(data), nil
+	}
+
+	return data, *meta, nil
+}
+
+func (fs *FileSource) Store(num int, kind Kind, data []byte) error {
+	return fs.StoreWithMetadata(num, kind, data, NewMetadata(data))
+}
+
+func (fs *FileSource) StoreWithMetadata(num int, kind Kind, data []byte, meta Metadata) error {
+	inputFile := getInputFile(fs.Directory, num, kind)
+	metaFile := getMetadataFile(fs.Directory, num, kind)
+
+	if err := meta.Check(data); err != nil {
+		return fmt.Errorf("downloader: FileSource: refusing to cache input: %s: %w", inputFile, err)
+	}
+
+	metaBytes, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return fmt.Errorf("downloader: FileSource: could not encode metadata: %w", err)
+	}
+
+	// metadata goes first: if we die before the input is written, the old input (if any) won't match the new metadata
+	// and will be downloaded again instead of trusted
+	err = WriteFileAtomic(metaFile, metaBytes, 0664)
+	if err != nil {
+		return fmt.Errorf("downloader: FileSource: could not write metadata: %s: %w", metaFile, err)
+	}
+
+	err = WriteFileAtomic(inputFile, data, 0664)
+	if err != nil {
+		return fmt.Errorf("downloader: FileSource: could not cache input: %s: %w", inputFile, err)
+	}
+
+	fs.logger().Debug("cached input", "puzzle", num, "kind", kind, "path", inputFile)
+
+	return nil
+}
+
+// HTTPSource downloads inputs from the puzzle site using a session token
+type HTTPSource struct {
+	baseURL   string
+	token     TokenProvider
+	client    *http.Client
+	userAgent string
+	retry     RetryPolicy
+	limiter   *rate.Limiter
+	offline   bool
+	logger    *slog.Logger
+}
+
+// NewHTTPSource builds an HTTPSource that talks to BaseURL with the default retry policy and rate limit. Use NewClient
+// with options to talk to anything else
+func NewHTTPSource(token TokenProvider) *HTTPSource {
+	return &HTTPSource{
+		baseURL:   BaseURL,
+		token:     token,
+		client:    http.DefaultClient,
+		userAgent: DefaultUserAgent,
+		retry:     DefaultRetryPolicy,
+		limiter:   rate.NewLimiter(DefaultRateLimit, DefaultRateBurst),
+		logger:    discardLogger,
+	}
+}
+
+func (hs *HTTPSource) Input(ctx context.Context, num int, kind Kind) ([]byte, error) {
+	data, _, err := hs.InputWithMetadata(ctx, num, kind)
+	return data, err
+}
+
+func (hs *HTTPSource) InputWithMetadata(ctx context.Context, num int, kind Kind) ([]byte, Metadata, error) {
+	if hs.offline {
+		return nil, Metadata{}, fmt.Errorf("downloader: HTTPSource: input for puzzle %d (input kind = %s): %w", num, kind, ErrNotCached)
+	}
+
+	hs.logger.Info("downloading input", "puzzle", num, "kind", kind)
+	var remoteInputURL string
+	if kind == TestInput {
+		remoteInputURL = fmt.Sprintf("%s/puzzle/%d/test-input", hs.baseURL, num)
+	} else {
+		remoteInputURL = fmt.Sprintf("%s/puzzle/%d/input", hs.baseURL, num)
+	}
+
+	token, err := hs.token(ctx)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+
+	resp, err := hs.get(ctx, remoteInputURL, token)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+
+	inputBytes := resp.Body
+
+	// the site answers with a (successful) HTML page rather than an error in some cases, like an expired token, so
+	// that counts as a failure too
+	contentType := resp.Header.Get("Content-Type")
+	if resp.StatusCode != http.StatusOK || isHTML(contentType) || looksLikeHTML(inputBytes) {
+		return nil, Metadata{}, newHTTPError(remoteInputURL, resp)
+	}
+
+	meta := NewMetadata(inputBytes)
+	meta.StatusCode = resp.StatusCode
+	meta.ContentType = contentType
+
+	return inputBytes, meta, nil
+}
+
+// get makes a GET request (with retries) to url, sending the session token if there is one
+func (hs *HTTPSource) get(ctx context.Context, url string, token string) (*response, error) {
+	return doWithRetries(ctx, hs.client, hs.limiter, hs.retry, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("downloader: HTTPSource: could not build request: %w", err)
+		}
+
+		if token != "" {
+			req.AddCookie(&http.Cookie{
+				Name:  cookieName,
+				Value: token,
+			})
+		}
+
+		req.Header.Set("User-Agent", hs.userAgent)
+
+		return req, nil
+	})
+}
+
+type memoryKey struct {
+	num  int
+	kind Kind
+}
+
+// MemorySource serves inputs that have been placed in it with Set (or Store). It is mostly useful for tests and
+// fixtures
+type MemorySource struct {
+	mu     sync.RWMutex
+	inputs map[memoryKey][]byte
+}
+
+func NewMemorySource() *MemorySource {
+	return &MemorySource{
+		inputs: map[memoryKey][]byte{},
+	}
+}
+
+func (ms *MemorySource) Set(num int, kind Kind, data []byte) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.inputs[memoryKey{num: num, kind: kind}] = data
+}
+
+func (ms *MemorySource) Input(_ context.Context, num int, kind Kind) ([]byte, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	data, ok := ms.inputs[memoryKey{num: num, kind: kind}]
+	if !ok {
+		return nil, ErrNoInput
+	}
+
+	return data, nil
+}
+
+func (ms *MemorySource) Store(num int, kind Kind, data []byte) error {
+	ms.Set(num, kind, data)
+	return nil
+}
+
+// ChainSource tries each of its sources in order until one of them has the input. Once found, the input is handed to
+// every earlier source that implements Storer, so a FileSource in front of an HTTPSource acts as a cache
+type ChainSource []Source
+
+func (cs ChainSource) Input(ctx context.Context, num int, kind Kind) ([]byte, error) {
+	data, _, err := cs.InputWithMetadata(ctx, num, kind)
+	return data, err
+}
+
+func (cs ChainSource) InputWithMetadata(ctx context.Context, num int, kind Kind) ([]byte, Metadata, error) {
+	for i, curr := range cs {
+		data, meta, err := inputWithMetadata(ctx, curr, num, kind)
+		if errors.Is(err, ErrNoInput) {
+			continue
+		}
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+
+		for _, earlier := range cs[:i] {
+			if ms, ok := earlier.(MetadataStorer); ok {
+				if err := ms.StoreWithMetadata(num, kind, data, meta); err != nil {
+					return nil, Metadata{}, err
+				}
+			} else if s, ok := earlier.(Storer); ok {
+				if err := s.Store(num, kind, data); err != nil {
+					return nil, Metadata{}, err
+				}
+			}
+		}
+
+		return data, meta, nil
+	}
+
+	return nil, Metadata{}, ErrNoInput
+}
@@ -0,0 +1,77 @@
+package input
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input/inputtest"
+)
+
+func Test_MemorySource(t *testing.T) {
+	ms := NewMemorySource()
+	ms.Set(5, TestInput, []byte("💩"))
+
+	data, err := ms.Input(context.Background(), 5, TestInput)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("💩"), data)
+
+	_, err = ms.Input(context.Background(), 5, RealInput)
+	assert.ErrorIs(t, err, ErrNoInput)
+}
+
+func Test_FileSource(t *testing.T) {
+	dir := t.TempDir()
+	fs := &FileSource{Directory: dir}
+
+	_, err := fs.Input(context.Background(), 1, RealInput)
+	assert.ErrorIs(t, err, ErrNoInput)
+
+	require.NoError(t, fs.Store(1, RealInput, []byte("hello")))
+	assert.FileExists(t, filepath.Join(dir, "01.txt"))
+
+	data, err := fs.Input(context.Background(), 1, RealInput)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("hello"), data)
+}
+
+func Test_HTTPSource(t *testing.T) {
+	srv := inputtest.NewServer(t, "secret")
+	srv.SetTestInput(3, []byte("test data"))
+
+	hs := NewHTTPSource(StaticToken("secret"))
+	hs.baseURL = srv.URL
+
+	data, err := hs.Input(context.Background(), 3, TestInput)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test data"), data)
+
+	_, err = hs.Input(context.Background(), 3, RealInput)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func Test_ChainSource(t *testing.T) {
+	dir := t.TempDir()
+	upstream := NewMemorySource()
+	upstream.Set(12, RealInput, []byte("phone book"))
+
+	c := &Client{
+		Source: ChainSource{&FileSource{Directory: dir}, upstream},
+	}
+
+	lines, err := c.GetInputLinesUTF8(context.Background(), 12, RealInput)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"phone book"}, lines)
+
+	// the file source in front should now have a copy
+	cached, err := os.ReadFile(filepath.Join(dir, "12.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("phone book"), cached)
+
+	_, err = c.GetInputBytes(context.Background(), 13, RealInput)
+	assert.ErrorIs(t, err, ErrNoInput)
+}
@@ -0,0 +1,315 @@
+package input
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	LedgerFileName = "submissions.json"
+
+	answerFormField = "answer"
+)
+
+type SubmissionStatus int
+
+const (
+	SubmissionUnknown SubmissionStatus = iota
+	SubmissionCorrect
+	SubmissionIncorrect
+	SubmissionRateLimited
+)
+
+func (s SubmissionStatus) String() string {
+	switch s {
+	case SubmissionCorrect:
+		return "CORRECT"
+	case SubmissionIncorrect:
+		return "INCORRECT"
+	case SubmissionRateLimited:
+		return "RATE_LIMITED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func (s SubmissionStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *SubmissionStatus) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "CORRECT":
+		*s = SubmissionCorrect
+	case "INCORRECT":
+		*s = SubmissionIncorrect
+	case "RATE_LIMITED":
+		*s = SubmissionRateLimited
+	default:
+		*s = SubmissionUnknown
+	}
+	return nil
+}
+
+// SubmissionResult is what we learned from submitting an answer
+type SubmissionResult struct {
+	Status SubmissionStatus
+
+	// RetryAfter is how long the server asked us to wait before trying again, if we were rate limited
+	RetryAfter time.Duration
+
+	// FromLedger is set if the result came from the local ledger and nothing was sent to the server
+	FromLedger bool
+}
+
+type ledgerEntry struct {
+	Answer      string           `json:"answer"`
+	Status      SubmissionStatus `json:"status"`
+	SubmittedAt time.Time        `json:"submitted_at"`
+}
+
+// ledger is every answer we've gotten a definitive response for, keyed by puzzle number
+type ledger map[int][]ledgerEntry
+
+func (l ledger) lookup(num int, answer string) (ledgerEntry, bool) {
+	for _, curr := range l[num] {
+		// only right and wrong are final, anything else (from a ledger written by an older version) doesn't count
+		if curr.Answer == answer && (curr.Status == SubmissionCorrect || curr.Status == SubmissionIncorrect) {
+			return curr, true
+		}
+	}
+
+	return ledgerEntry{}, false
+}
+
+func (c *Client) ledgerFile() string {
+	return filepath.Join(c.cacheDir, LedgerFileName)
+}
+
+func (c *Client) readLedger() (ledger, error) {
+	f, err := os.ReadFile(c.ledgerFile())
+	if errors.Is(err, os.ErrNotExist) {
+		return ledger{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("downloader: readLedger: could not read ledger: %w", err)
+	}
+
+	l := ledger{}
+	err = json.Unmarshal(f, &l)
+	if err != nil {
+		return nil, fmt.Errorf("downloader: readLedger: could not parse ledger: %s: %w", c.ledgerFile(), err)
+	}
+
+	return l, nil
+}
+
+func (c *Client) writeLedger(l ledger) error {
+	b, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return fmt.Errorf("downloader: writeLedger: could not encode ledger: %w", err)
+	}
+
+	err = WriteFileAtomic(c.ledgerFile(), b, 0664)
+	if err != nil {
+		return fmt.Errorf("downloader: writeLedger: could not write ledger: %w", err)
+	}
+
+	return nil
+}
+
+// SubmitAnswer sends an answer for a puzzle to the site. Answers that we already know are right or wrong are answered
+// from the local ledger without contacting the server, so a known-wrong answer is never sent twice. A response that
+// doesn't clearly say how the answer was judged is an ErrUnrecognizedSubmission, and isn't recorded
+func (c *Client) SubmitAnswer(ctx context.Context, num int, answer string) (*SubmissionResult, error) {
+	answer = strings.TrimSpace(answer)
+
+	c.ledgerMu.Lock()
+	defer c.ledgerMu.Unlock()
+
+	l, err := c.readLedger()
+	if err != nil {
+		return nil, err
+	}
+
+	if prev, ok := l.lookup(num, answer); ok {
+		return &SubmissionResult{
+			Status:     prev.Status,
+			FromLedger: true,
+		}, nil
+	}
+
+	res, err := c.postAnswer(ctx, num, answer)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status == SubmissionCorrect || res.Status == SubmissionIncorrect {
+		l[num] = append(l[num], ledgerEntry{
+			Answer:      answer,
+			Status:      res.Status,
+			SubmittedAt: time.Now().UTC(),
+		})
+		err = c.writeLedger(l)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Client) postAnswer(ctx context.Context, num int, answer string) (*SubmissionResult, error) {
+	if c.offline {
+		// only answers already in the ledger can be checked offline
+		return nil, fmt.Errorf("downloader: SubmitAnswer: answer for puzzle %d is not in the ledger: %w", num, ErrNotCached)
+	}
+
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set(answerFormField, answer)
+
+	submitURL := fmt.Sprintf("%s/puzzle/%d/submit/", c.baseURL, num)
+
+	// submitting isn't safe to repeat (and a 429 here is about answering too often, which waiting a few seconds won't
+	// fix), so there are no retries. It still counts against the rate limit though
+	resp, err := doWithRetries(ctx, c.httpClient, c.limiter, NoRetries, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, submitURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return nil, fmt.Errorf("downloader: SubmitAnswer: could not build request: %w", err)
+		}
+
+		req.AddCookie(&http.Cookie{
+			Name:  cookieName,
+			Value: token,
+		})
+
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Referer", fmt.Sprintf("%s/puzzle/%d/", c.baseURL, num))
+		req.Header.Set("User-Agent", c.userAgent)
+
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSubmissionResponse(submitURL, resp)
+}
+
+// ErrUnrecognizedSubmission means the response to a submitted answer didn't clearly say whether it was right, wrong or
+// rate limited. Nothing is recorded in the ledger for it, so the answer can be submitted again
+var ErrUnrecognizedSubmission = errors.New("downloader: could not tell how the site judged the answer")
+
+// messageStatuses are the levels of the messages the site shows after a form is posted, and what they mean for a
+// submitted answer. Messages are in <ul class="messages">, with the level as the class of each <li>
+var messageStatuses = map[string]SubmissionStatus{
+	"success": SubmissionCorrect,
+	"error":   SubmissionIncorrect,
+	"warning": SubmissionRateLimited,
+}
+
+func parseSubmissionResponse(submitURL string, resp *response) (*SubmissionResult, error) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return &SubmissionResult{
+			Status:     SubmissionRateLimited,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newHTTPError(submitURL, resp)
+	}
+
+	doc, err := html.Parse(bytes.NewReader(resp.Body))
+	if err != nil {
+		return nil, fmt.Errorf("downloader: SubmitAnswer: %s: could not parse HTML: %w", submitURL, err)
+	}
+
+	// only the messages are looked at, since the rest of the page (the puzzle statement, for one) is free to talk about
+	// right and wrong answers
+	status := SubmissionUnknown
+	for n := range doc.Descendants() {
+		if n.Type != html.ElementNode || n.DataAtom != atom.Li || n.Parent == nil || !hasClass(n.Parent, "messages") {
+			continue
+		}
+
+		for level, curr := range messageStatuses {
+			if !hasClass(n, level) {
+				continue
+			}
+			if status != SubmissionUnknown && status != curr {
+				return nil, fmt.Errorf("%w: %s: the page says the answer is both %s and %s", ErrUnrecognizedSubmission, submitURL, status, curr)
+			}
+			status = curr
+		}
+	}
+
+	switch status {
+	case SubmissionUnknown:
+		return nil, fmt.Errorf("%w: %s: no result message on the page", ErrUnrecognizedSubmission, submitURL)
+	case SubmissionRateLimited:
+		return &SubmissionResult{
+			Status:     SubmissionRateLimited,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}, nil
+	default:
+		return &SubmissionResult{Status: status}, nil
+	}
+}
+
+// hasClass reports whether one of the classes of an element is class
+func hasClass(n *html.Node, class string) bool {
+	for _, attr := range n.Attr {
+		if attr.Key == "class" && slices.Contains(strings.Fields(attr.Val), class) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseRetryAfter handles both forms of the Retry-After header (a number of seconds or an HTTP date). Anything we
+// can't understand is treated as no delay
+func parseRetryAfter(x string) time.Duration {
+	if x == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(x); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+
+	if when, err := http.ParseTime(x); err == nil {
+		return max(time.Until(when), 0)
+	}
+
+	return 0
+}
+
+func SubmitAnswer(ctx context.Context, num int, answer string) (*SubmissionResult, error) {
+	c, err := defaultClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SubmitAnswer(ctx, num, answer)
+}
@@ -0,0 +1,145 @@
+package input
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input/inputtest"
+)
+
+func Test_SubmitAnswer(t *testing.T) {
+	srv := inputtest.NewServer(t, "secret")
+	srv.SetAnswer(7, "42")
+	submissions := func() int {
+		return srv.Count("/puzzle/7/submit/")
+	}
+
+	c, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithCacheDir(t.TempDir()),
+		WithTokenProvider(StaticToken("secret")),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("wrong answer is only sent once", func(t *testing.T) {
+		res, err := c.SubmitAnswer(ctx, 7, "41")
+		assert.NoError(t, err)
+		assert.Equal(t, SubmissionIncorrect, res.Status)
+		assert.False(t, res.FromLedger)
+
+		res, err = c.SubmitAnswer(ctx, 7, " 41\n")
+		assert.NoError(t, err)
+		assert.Equal(t, SubmissionIncorrect, res.Status)
+		assert.True(t, res.FromLedger)
+
+		assert.Equal(t, 1, submissions())
+	})
+
+	t.Run("correct answer", func(t *testing.T) {
+		res, err := c.SubmitAnswer(ctx, 7, "42")
+		assert.NoError(t, err)
+		assert.Equal(t, SubmissionCorrect, res.Status)
+	})
+
+	t.Run("rate limited answers are not recorded", func(t *testing.T) {
+		before := submissions()
+		srv.Inject(inputtest.Fault{Path: "/puzzle/7/submit/", Status: http.StatusTooManyRequests, RetryAfter: "30", Times: 2})
+
+		res, err := c.SubmitAnswer(ctx, 7, "slow")
+		assert.NoError(t, err)
+		assert.Equal(t, SubmissionRateLimited, res.Status)
+		assert.Equal(t, 30*time.Second, res.RetryAfter)
+
+		_, err = c.SubmitAnswer(ctx, 7, "slow")
+		assert.NoError(t, err)
+		assert.Equal(t, before+2, submissions())
+	})
+
+	t.Run("unrecognized responses are not recorded", func(t *testing.T) {
+		before := submissions()
+		srv.Inject(inputtest.Fault{Path: "/puzzle/7/submit/", Status: http.StatusOK, Body: "<p>The correct answer is...</p>", Times: 1})
+
+		_, err := c.SubmitAnswer(ctx, 7, "maybe")
+		assert.ErrorIs(t, err, ErrUnrecognizedSubmission)
+
+		res, err := c.SubmitAnswer(ctx, 7, "maybe")
+		assert.NoError(t, err)
+		assert.Equal(t, SubmissionIncorrect, res.Status)
+		assert.False(t, res.FromLedger)
+		assert.Equal(t, before+2, submissions())
+	})
+
+	t.Run("ledger survives a new client", func(t *testing.T) {
+		c2, err := NewClient(
+			WithBaseURL(srv.URL),
+			WithCacheDir(c.CacheDir()),
+			WithTokenProvider(StaticToken("secret")),
+		)
+		require.NoError(t, err)
+
+		res, err := c2.SubmitAnswer(ctx, 7, "42")
+		assert.NoError(t, err)
+		assert.Equal(t, SubmissionCorrect, res.Status)
+		assert.True(t, res.FromLedger)
+	})
+}
+
+func Test_parseSubmissionResponse(t *testing.T) {
+	page := func(messages string) []byte {
+		return []byte(`<!DOCTYPE html>
+<html>
+<head><title>i18n puzzles</title></head>
+<body>
+` + messages + `
+<div class="puzzle">
+<h3>Puzzle 7: The audit trail fixer</h3>
+<p>Once you have the correct answer, enter it below. The right answer is a number.</p>
+<form method="post" action="/puzzle/7/submit/"><input name="answer"></form>
+</div>
+</body>
+</html>`)
+	}
+
+	tests := map[string]struct {
+		body   []byte
+		status SubmissionStatus
+	}{
+		"correct":           {body: page(`<ul class="messages"><li class="success">That's the right answer!</li></ul>`), status: SubmissionCorrect},
+		"incorrect":         {body: page(`<ul class="messages"><li class="error">That's not the right answer.</li></ul>`), status: SubmissionIncorrect},
+		"too soon":          {body: page(`<ul class="messages"><li class="warning">Please wait a minute before answering again.</li></ul>`), status: SubmissionRateLimited},
+		"extra classes":     {body: page(`<ul class="messages list"><li class="alert success">Correct!</li><li class="info">Puzzle 8 is out</li></ul>`), status: SubmissionCorrect},
+		"message only":      {body: []byte(`<ul class="messages"><li class="error">Wrong</li></ul>`), status: SubmissionIncorrect},
+		"incorrect message": {body: page(`<ul class="messages"><li class="error">Your answer is correct for a different input.</li></ul>`), status: SubmissionIncorrect},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := parseSubmissionResponse("/submit/", &response{StatusCode: http.StatusOK, Header: http.Header{}, Body: tc.body})
+			require.NoError(t, err)
+			assert.Equal(t, tc.status, res.Status)
+		})
+	}
+
+	t.Run("ambiguous", func(t *testing.T) {
+		for name, body := range map[string][]byte{
+			"puzzle page":       page(""),
+			"other messages":    page(`<ul class="messages"><li class="info">Welcome back</li></ul>`),
+			"contradictory":     page(`<ul class="messages"><li class="success">Yes</li><li class="error">No</li></ul>`),
+			"not a list":        page(`<p class="success">That's the right answer!</p>`),
+			"plain text":        []byte("That's the right answer!"),
+			"empty":             nil,
+			"login page":        []byte(`<html><body><form action="/login/"><p>Log in to submit the correct answer</p></form></body></html>`),
+			"classless message": page(`<ul class="messages"><li>That's the right answer!</li></ul>`),
+		} {
+			_, err := parseSubmissionResponse("/submit/", &response{StatusCode: http.StatusOK, Header: http.Header{}, Body: body})
+			assert.ErrorIs(t, err, ErrUnrecognizedSubmission, name)
+		}
+	})
+}
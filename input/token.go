@@ -0,0 +1,78 @@
+package input
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNoToken is returned by a TokenProvider that has no token to give
+var ErrNoToken = errors.New("downloader: no session token available")
+
+// TokenProvider returns the session token used to authenticate with the puzzle site. It is only called when a request
+// actually needs to be made
+type TokenProvider func(ctx context.Context) (string, error)
+
+// StaticToken always returns the given token
+func StaticToken(token string) TokenProvider {
+	return func(_ context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+// TokenFromFile reads the token from a file, ignoring surrounding whitespace
+func TokenFromFile(path string) TokenProvider {
+	return func(_ context.Context) (string, error) {
+		f, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("downloader: TokenFromFile: could not read token file: %s: %w", path, ErrNoToken)
+		}
+		if err != nil {
+			return "", fmt.Errorf("downloader: TokenFromFile: could not read token file: %s: %w", path, err)
+		}
+
+		return strings.TrimSpace(string(f)), nil
+	}
+}
+
+// TokenFromEnv reads the token from an environment variable
+func TokenFromEnv(name string) TokenProvider {
+	return func(_ context.Context) (string, error) {
+		token := strings.TrimSpace(os.Getenv(name))
+		if token == "" {
+			return "", fmt.Errorf("downloader: TokenFromEnv: %s is not set: %w", name, ErrNoToken)
+		}
+
+		return token, nil
+	}
+}
+
+// FirstToken tries each provider in order and returns the first token found
+func FirstToken(providers ...TokenProvider) TokenProvider {
+	return func(ctx context.Context) (string, error) {
+		var errs []error
+		for _, curr := range providers {
+			token, err := curr(ctx)
+			if err == nil {
+				return token, nil
+			}
+			if !errors.Is(err, ErrNoToken) {
+				return "", err
+			}
+			errs = append(errs, err)
+		}
+
+		if len(errs) == 0 {
+			return "", ErrNoToken
+		}
+
+		return "", errors.Join(errs...)
+	}
+}
+
+func tokenFile(directory string) string {
+	return filepath.Join(directory, TokenFileName)
+}
@@ -0,0 +1,117 @@
+package lengths
+
+import (
+	"context"
+	"io"
+	"unicode/utf8"
+
+	"github.com/lthummus/i18n-puzzles/grapheme"
+	"github.com/lthummus/i18n-puzzles/sms"
+	"github.com/lthummus/i18n-puzzles/solver"
+	"github.com/lthummus/i18n-puzzles/textlen"
+)
+
+const puzzleNumber = 1
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "lengths",
+	Solver: solver.SolverFunc(Solve),
+}
+
+const (
+	MaxSMSBytes   = 160
+	MaxTweetChars = 140
+)
+
+// SMSRule decides whether a message can be sent as a single SMS
+type SMSRule func(x string) bool
+
+// PuzzleSMSRule is the puzzle's rule: a message fits if it is at most 160 bytes of UTF-8
+func PuzzleSMSRule(x string) bool {
+	return len(x) <= MaxSMSBytes
+}
+
+// CarrierSMSRule is what a real carrier does: a message fits if it takes one segment, which is 160 GSM-7 septets
+// (escaped characters like € taking two) or 70 UTF-16 code units if it needs UCS-2
+func CarrierSMSRule(x string) bool {
+	return len(sms.Split(x).Segments) == 1
+}
+
+// TweetRule decides whether a message can be sent as a single tweet
+type TweetRule func(x string) bool
+
+// PuzzleTweetRule is the puzzle's rule: a message fits if it is at most 140 code points
+func PuzzleTweetRule(x string) bool {
+	return utf8.RuneCountInString(x) <= MaxTweetChars
+}
+
+// GraphemeTweetRule counts what people would think of as characters instead of code points, so an emoji made of
+// several code points only counts once
+func GraphemeTweetRule(x string) bool {
+	return grapheme.Count(x) <= MaxTweetChars
+}
+
+// PlatformTweetRule is what Twitter does now: a message fits if its weighted length (CJK and emoji counting 2, links
+// counting 23) is at most 280
+func PlatformTweetRule(x string) bool {
+	return textlen.TweetLimit.Fits(x)
+}
+
+// Rules are the ways of deciding what fits in an SMS and a tweet
+type Rules struct {
+	SMS   SMSRule
+	Tweet TweetRule
+}
+
+// PuzzleRules are the rules from the puzzle statement, which give the expected answer
+var PuzzleRules = Rules{SMS: PuzzleSMSRule, Tweet: PuzzleTweetRule}
+
+// RealRules are how carriers and Twitter actually measure messages
+var RealRules = Rules{SMS: CarrierSMSRule, Tweet: PlatformTweetRule}
+
+func getCost(x string, rules Rules) int {
+	canTweet := rules.Tweet(x)
+	canSMS := rules.SMS(x)
+
+	if canTweet && canSMS {
+		return 13
+	} else if canTweet {
+		return 7
+	} else if canSMS {
+		return 11
+	} else {
+		return 0
+	}
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	return solve(r, PuzzleRules)
+}
+
+// SolveWith makes a solver that decides what fits in an SMS or a tweet with other rules than the puzzle's, such as
+// RealRules
+func SolveWith(rules Rules) solver.SolverFunc {
+	return func(ctx context.Context, r io.Reader) (solver.Answer, error) {
+		return solve(r, rules)
+	}
+}
+
+func solve(r io.Reader, rules Rules) (solver.Answer, error) {
+	input, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+
+	totalCost := 0
+
+	for _, curr := range input {
+		totalCost += getCost(curr, rules)
+	}
+
+	return solver.Answerf("%d", totalCost), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
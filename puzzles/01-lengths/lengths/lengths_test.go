@@ -0,0 +1,36 @@
+package lengths
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getCost(t *testing.T) {
+	t.Run("puzzle rules", func(t *testing.T) {
+		assert.Equal(t, 13, getCost("short", PuzzleRules))
+		assert.Equal(t, 11, getCost(strings.Repeat("a", 150), PuzzleRules))
+		assert.Equal(t, 7, getCost(strings.Repeat("é", 100), PuzzleRules), "200 bytes is too long for an SMS")
+		assert.Equal(t, 0, getCost(strings.Repeat("é", 150), PuzzleRules))
+	})
+
+	t.Run("carrier rule", func(t *testing.T) {
+		rules := Rules{SMS: CarrierSMSRule, Tweet: PuzzleTweetRule}
+		assert.Equal(t, 13, getCost("short", rules))
+		assert.Equal(t, 13, getCost(strings.Repeat("é", 100), rules), "é is in GSM-7, so it's one septet")
+		assert.Equal(t, 11, getCost(strings.Repeat("é", 150), rules))
+		assert.Equal(t, 7, getCost(strings.Repeat("€", 81), rules), "€ takes two septets")
+		assert.Equal(t, 7, getCost(strings.Repeat("ж", 71), rules), "UCS-2 only has room for 70")
+	})
+
+	t.Run("tweet rules", func(t *testing.T) {
+		family := strings.Repeat("👨‍👩‍👧", 30)
+		assert.Equal(t, 0, getCost(family, PuzzleRules), "150 code points")
+		assert.Equal(t, 7, getCost(family, Rules{SMS: PuzzleSMSRule, Tweet: GraphemeTweetRule}))
+		assert.Equal(t, 7, getCost(family, RealRules))
+
+		assert.Equal(t, 13, getCost(strings.Repeat("a", 200), Rules{SMS: func(string) bool { return true }, Tweet: PlatformTweetRule}))
+		assert.Equal(t, 0, getCost(strings.Repeat("日", 141), RealRules))
+	})
+}
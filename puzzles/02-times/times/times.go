@@ -0,0 +1,49 @@
+package times
+
+import (
+	"context"
+	"io"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+	"github.com/lthummus/i18n-puzzles/timestamp"
+)
+
+const puzzleNumber = 2
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "times",
+	Solver: solver.SolverFunc(Solve),
+}
+
+const (
+	TimeFormatString       = "2006-01-02T15:04:05-07:00"
+	DetectionCountRequired = 4
+)
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	input, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+
+	events := make([]timestamp.Event, len(input))
+	for i := range input {
+		events[i], err = timestamp.ParseEvent(i+1, input[i])
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, i+1, input[i], err)
+		}
+	}
+
+	detections := timestamp.Coincident(events, 0, DetectionCountRequired)
+	if len(detections) == 0 {
+		return "", solver.Errorf(puzzleNumber, "no time seen at least %d times", DetectionCountRequired)
+	}
+
+	// the puzzle promises there's only one, so if there happen to be more, go with the earliest
+	return solver.Answer(detections[0].Start.Format(TimeFormatString)), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
@@ -0,0 +1,64 @@
+package times
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+func solve(t *testing.T, lines ...string) (solver.Answer, error) {
+	t.Helper()
+	return Solve(context.Background(), strings.NewReader(strings.Join(lines, "\n")+"\n"))
+}
+
+func Test_Solve(t *testing.T) {
+	t.Run("mixed formats", func(t *testing.T) {
+		ans, err := solve(t,
+			"2019-06-05T08:15:00-04:00",
+			"2019-06-05T12:15:01Z",
+			"Wed, 05 Jun 2019 12:15:00 +0000",
+			"2019-06-05T14:15:00+02:00",
+			"2001-01-01T00:00:00Z",
+			"1559736900",
+		)
+		require.NoError(t, err)
+		assert.Equal(t, solver.Answer("2019-06-05T12:15:00+00:00"), ans)
+	})
+
+	t.Run("several times seen often enough", func(t *testing.T) {
+		lines := []string{
+			"2019-06-05T12:15:00Z",
+			"2019-W23-3T12:15:00Z",
+			"1559736900",
+			"Wed, 05 Jun 2019 14:15:00 +0200",
+			"2001-01-01T00:00:00Z",
+			"978307200",
+			"2000-12-31T19:00:00-05:00",
+			"Mon, 01 Jan 2001 01:00:00 +0100",
+		}
+
+		// the earliest one wins, every time, whatever order they're in
+		for range 20 {
+			ans, err := solve(t, lines...)
+			require.NoError(t, err)
+			assert.Equal(t, solver.Answer("2001-01-01T00:00:00+00:00"), ans)
+		}
+	})
+
+	t.Run("nothing seen often enough", func(t *testing.T) {
+		_, err := solve(t, "2019-06-05T12:15:00Z", "1559736900", "2001-01-01T00:00:00Z")
+		assert.Error(t, err)
+	})
+
+	t.Run("bad timestamp", func(t *testing.T) {
+		_, err := solve(t, "2019-06-05T12:15:00Z", "yesterday")
+		var ie *solver.InputError
+		require.ErrorAs(t, err, &ie)
+		assert.Equal(t, 2, ie.Line)
+	})
+}
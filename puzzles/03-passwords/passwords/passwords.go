@@ -0,0 +1,94 @@
+package passwords
+
+import (
+	"context"
+	"io"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/lthummus/i18n-puzzles/grapheme"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 3
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "passwords",
+	Solver: solver.SolverFunc(Solve),
+}
+
+const (
+	MaxLength = 12
+	MinLength = 4
+)
+
+// Length measures how long a password is
+type Length func(pwd string) int
+
+// RuneLength counts code points, which is what the puzzle means by the length of a password
+func RuneLength(pwd string) int {
+	return utf8.RuneCountInString(pwd)
+}
+
+// GraphemeLength counts grapheme clusters instead, so a letter written with a combining accent only counts once
+func GraphemeLength(pwd string) int {
+	return grapheme.Count(pwd)
+}
+
+func isPasswordValid(pwd string, length Length) bool {
+	digitFound := false
+	upperFound := false
+	lowerFound := false
+	nonASCIIFound := false
+
+	for _, r := range pwd {
+		if r > unicode.MaxASCII {
+			nonASCIIFound = true
+		}
+
+		if unicode.IsDigit(r) {
+			digitFound = true
+		} else if unicode.IsUpper(r) {
+			upperFound = true
+		} else if unicode.IsLower(r) {
+			lowerFound = true
+		}
+	}
+
+	n := length(pwd)
+	lengthOK := n >= MinLength && n <= MaxLength
+
+	return lengthOK && digitFound && upperFound && lowerFound && nonASCIIFound
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	return solve(r, RuneLength)
+}
+
+// SolveWith makes a solver that measures passwords some other way than the puzzle does, such as GraphemeLength
+func SolveWith(length Length) solver.SolverFunc {
+	return func(ctx context.Context, r io.Reader) (solver.Answer, error) {
+		return solve(r, length)
+	}
+}
+
+func solve(r io.Reader, length Length) (solver.Answer, error) {
+	in, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+
+	validCount := 0
+	for _, curr := range in {
+		if isPasswordValid(curr, length) {
+			validCount++
+		}
+	}
+
+	return solver.Answerf("%d", validCount), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
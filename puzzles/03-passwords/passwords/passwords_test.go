@@ -0,0 +1,17 @@
+package passwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isPasswordValid(t *testing.T) {
+	assert.True(t, isPasswordValid("Pässwörd1", RuneLength))
+	assert.False(t, isPasswordValid("Password1", RuneLength), "no non-ASCII characters")
+
+	// the é is an e with a combining accent, which is two code points but one grapheme cluster
+	long := "Abcdefghij1e\u0301"
+	assert.False(t, isPasswordValid(long, RuneLength))
+	assert.True(t, isPasswordValid(long, GraphemeLength))
+}
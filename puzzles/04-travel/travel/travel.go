@@ -0,0 +1,79 @@
+package travel
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 4
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "travel",
+	Solver: solver.SolverFunc(Solve),
+}
+
+var (
+	entryRegex = regexp.MustCompile(`Departure: ([A-Za-z/_-]+)\s+(.+)\nArrival: {3}([A-Za-z/_-]+)\s+(.+)`)
+
+	timeFormatString = "Jan 02, 2006, 15:04"
+)
+
+// flightTime computes the length of a flight in minutes. line is the line number of the departure line, which is
+// followed immediately by the arrival line
+func flightTime(entryMatches []string, line int) (int, error) {
+	departLine, arriveLine, _ := strings.Cut(entryMatches[0], "\n")
+
+	departZone, err := time.LoadLocation(entryMatches[1])
+	if err != nil {
+		return 0, solver.AtLine(puzzleNumber, line, departLine, err)
+	}
+	arriveZone, err := time.LoadLocation(entryMatches[3])
+	if err != nil {
+		return 0, solver.AtLine(puzzleNumber, line+1, arriveLine, err)
+	}
+
+	departTime, err := time.ParseInLocation(timeFormatString, entryMatches[2], departZone)
+	if err != nil {
+		return 0, solver.AtLine(puzzleNumber, line, departLine, err)
+	}
+
+	arriveTime, err := time.ParseInLocation(timeFormatString, entryMatches[4], arriveZone)
+	if err != nil {
+		return 0, solver.AtLine(puzzleNumber, line+1, arriveLine, err)
+	}
+
+	return int(arriveTime.Sub(departTime).Minutes()), nil
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	in, err := solver.ReadString(r)
+	if err != nil {
+		return "", err
+	}
+
+	m := entryRegex.FindAllStringSubmatch(in, -1)
+	idx := entryRegex.FindAllStringIndex(in, -1)
+
+	var travelTime int
+	for i, curr := range m {
+		line := strings.Count(in[:idx[i][0]], "\n") + 1
+
+		ft, err := flightTime(curr, line)
+		if err != nil {
+			return "", err
+		}
+		travelTime += ft
+	}
+
+	return solver.Answerf("%d", travelTime), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
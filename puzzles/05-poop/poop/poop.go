@@ -0,0 +1,49 @@
+package poop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/lthummus/i18n-puzzles/grid"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 5
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "poop",
+	Solver: solver.SolverFunc(Solve),
+}
+
+var slope = grid.Slope{Right: 2, Down: 1}
+
+const poop = '💩'
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	lines, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+
+	m, err := grid.Parse(lines)
+	var ragged *grid.RaggedError
+	if errors.As(err, &ragged) {
+		return "", solver.AtLine(puzzleNumber, ragged.Row+1, lines[ragged.Row], fmt.Errorf("expected %d characters, got %d", ragged.Want, ragged.Cells))
+	} else if err != nil {
+		return "", solver.Errorf(puzzleNumber, "could not read map: %w", err)
+	}
+
+	solver.Logger(ctx).Debug("map size", "width", m.Width(), "height", m.Height())
+
+	// the walk stops as soon as it gets to the bottom row, without looking at what's there
+	poops := m.Count(slope, m.Height()-1, grid.StartsWith(poop))
+
+	return solver.Answerf("%d", poops), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
@@ -0,0 +1,27 @@
+package poop
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+func Test_SolveBadMaps(t *testing.T) {
+	t.Run("ragged", func(t *testing.T) {
+		_, err := Solve(context.Background(), strings.NewReader("💩 \n 💩💩\n  \n"))
+		var ie *solver.InputError
+		require.ErrorAs(t, err, &ie)
+		assert.Equal(t, 2, ie.Line)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := Solve(context.Background(), strings.NewReader(""))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no cells")
+	})
+}
@@ -0,0 +1,101 @@
+package mojibake
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 6
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "mojibake",
+	Solver: solver.SolverFunc(Solve),
+}
+
+func demangle(x string) (string, error) {
+	ld := charmap.ISO8859_1.NewEncoder()
+	x2, err := ld.String(x)
+	if err != nil {
+		return "", fmt.Errorf("demangle: could not re-encode as ISO-8859-1: %w", err)
+	}
+
+	return x2, nil
+}
+
+func findWordSolution(dict []string, template string) (int, error) {
+	// this is a good idea? lol
+	rx, err := regexp.Compile(fmt.Sprintf("^%s$", strings.TrimSpace(template)))
+	if err != nil {
+		return 0, fmt.Errorf("findWordSolution: invalid template: %w", err)
+	}
+
+	for i := range dict {
+		if rx.MatchString(dict[i]) {
+			return i + 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("findWordSolution: no word fits")
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	in, err := solver.ReadString(r)
+	if err != nil {
+		return "", err
+	}
+
+	sections, err := input.SectionsN(in, 2)
+	if err != nil {
+		return "", solver.Errorf(puzzleNumber, "%w", err)
+	}
+	words, slots := sections[0], sections[1]
+
+	fixedWords := make([]string, len(words.Lines))
+
+	for i := range words.Lines {
+		wordNum := i + 1
+
+		curr := words.Lines[i]
+
+		// decode every 3rd and every 5th line. Every 15th line should be decoded twice
+		if wordNum%3 == 0 {
+			curr, err = demangle(curr)
+			if err != nil {
+				return "", solver.AtLine(puzzleNumber, words.LineNumber(i), words.Lines[i], err)
+			}
+		}
+		if wordNum%5 == 0 {
+			curr, err = demangle(curr)
+			if err != nil {
+				return "", solver.AtLine(puzzleNumber, words.LineNumber(i), words.Lines[i], err)
+			}
+		}
+
+		fixedWords[i] = curr
+	}
+
+	total := 0
+	for i, curr := range slots.Lines {
+		idx, err := findWordSolution(fixedWords, curr)
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, slots.LineNumber(i), curr, err)
+		}
+		total += idx
+	}
+
+	return solver.Answerf("%d", total), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
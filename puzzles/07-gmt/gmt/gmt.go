@@ -0,0 +1,123 @@
+package gmt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 7
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "gmt",
+	Solver: solver.SolverFunc(Solve),
+}
+
+var (
+	halifaxZone  *time.Location
+	santiagoZone *time.Location
+
+	// the zones are loaded on first use so a system without tzdata can still import this package
+	zonesOnce sync.Once
+	zonesErr  error
+)
+
+func loadZones() error {
+	zonesOnce.Do(func() {
+		halifaxZone, zonesErr = time.LoadLocation("America/Halifax")
+		if zonesErr != nil {
+			return
+		}
+
+		santiagoZone, zonesErr = time.LoadLocation("America/Santiago")
+	})
+
+	return zonesErr
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
+
+// fieldColumn returns the (1-based) column that the field at idx starts at in a tab separated line
+func fieldColumn(parts []string, idx int) int {
+	col := 1
+	for _, curr := range parts[:idx] {
+		col += utf8.RuneCountInString(curr) + 1
+	}
+	return col
+}
+
+func fix(entry string) (time.Time, error) {
+	parts := strings.Split(entry, "\t")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("fix: expected 3 tab separated fields, found %d", len(parts))
+	}
+
+	parsed, err := time.Parse("2006-01-02T15:04:05.000-07:00", parts[0])
+	if err != nil {
+		return time.Time{}, solver.AtColumn(fieldColumn(parts, 0), err)
+	}
+
+	_, offset := parsed.Zone()
+	utc := parsed.UTC()
+
+	halifaxTime := utc.In(halifaxZone)
+
+	_, halifaxOffset := halifaxTime.Zone()
+
+	var fixedTime time.Time
+	if halifaxOffset == offset {
+		fixedTime = halifaxTime
+	} else {
+		fixedTime = utc.In(santiagoZone)
+	}
+
+	toAdd, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return time.Time{}, solver.AtColumn(fieldColumn(parts, 1), err)
+	}
+
+	toSub, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return time.Time{}, solver.AtColumn(fieldColumn(parts, 2), err)
+	}
+
+	fixedTime = fixedTime.Add(time.Duration(toAdd) * time.Minute)
+	fixedTime = fixedTime.Add(time.Duration(-toSub) * time.Minute)
+
+	return fixedTime, nil
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	in, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+
+	err = loadZones()
+	if err != nil {
+		return "", fmt.Errorf("gmt: Solve: could not load time zones: %w", err)
+	}
+
+	var total int
+
+	for i := range in {
+		lineNum := i + 1
+		fixedTime, err := fix(in[i])
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, lineNum, in[i], err)
+		}
+		total += fixedTime.Hour() * lineNum
+	}
+
+	return solver.Answerf("%d", total), nil
+}
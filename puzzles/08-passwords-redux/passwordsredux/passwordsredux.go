@@ -0,0 +1,136 @@
+package passwordsredux
+
+import (
+	"context"
+	"io"
+	"iter"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/lthummus/i18n-puzzles/grapheme"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 8
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "passwords-redux",
+	Solver: solver.SolverFunc(Solve),
+}
+
+const (
+	MaxLength = 12
+	MinLength = 4
+)
+
+var (
+	vowels = map[rune]bool{
+		'a': true,
+		'e': true,
+		'i': true,
+		'o': true,
+		'u': true,
+	}
+)
+
+// Characters splits a password in to the characters that are counted and checked for repeats. Each one is in NFD, so
+// its first byte is the letter without any accents
+type Characters func(pwd string) iter.Seq[string]
+
+// Segments splits a password in to normalization segments, which is what the puzzle was solved with. For the letters
+// in the puzzle these are the same as grapheme clusters, but flags and emoji joined with a ZWJ are split in to
+// several segments
+func Segments(pwd string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		var iter norm.Iter
+		iter.InitString(norm.NFD, pwd)
+
+		for !iter.Done() {
+			if !yield(string(iter.Next())) {
+				return
+			}
+		}
+	}
+}
+
+// Graphemes splits a password in to grapheme clusters, which are what a person would count as characters
+func Graphemes(pwd string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for c := range grapheme.All(pwd) {
+			if !yield(norm.NFD.String(c)) {
+				return
+			}
+		}
+	}
+}
+
+func isPasswordValid(pwd string, characters Characters) bool {
+	length := 0
+
+	digitFound := false
+	vowelFound := false
+	consFound := false
+
+	seen := map[rune]bool{}
+
+	for r := range characters(pwd) {
+		length++
+
+		baseRune := rune(r[0])
+
+		if unicode.IsDigit(baseRune) {
+			digitFound = true
+		} else if unicode.IsLetter(baseRune) {
+			baseRune = unicode.ToLower(baseRune)
+			if vowels[baseRune] {
+				vowelFound = true
+			} else {
+				consFound = true
+			}
+		}
+
+		// have to do this AFTER normalization shenanigans
+		if seen[baseRune] {
+			return false
+		}
+		seen[baseRune] = true
+	}
+
+	lengthOK := length >= MinLength && length <= MaxLength
+
+	return lengthOK && digitFound && consFound && vowelFound
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	return solve(r, Segments)
+}
+
+// SolveWith makes a solver that splits passwords in to characters some other way than the puzzle does, such as
+// Graphemes
+func SolveWith(characters Characters) solver.SolverFunc {
+	return func(ctx context.Context, r io.Reader) (solver.Answer, error) {
+		return solve(r, characters)
+	}
+}
+
+func solve(r io.Reader, characters Characters) (solver.Answer, error) {
+	lines, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+
+	validCount := 0
+	for _, curr := range lines {
+		if isPasswordValid(curr, characters) {
+			validCount++
+		}
+	}
+
+	return solver.Answerf("%d", validCount), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
@@ -0,0 +1,20 @@
+package passwordsredux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isPasswordValid(t *testing.T) {
+	for _, characters := range []Characters{Segments, Graphemes} {
+		assert.True(t, isPasswordValid("Ab1é", characters))
+		assert.False(t, isPasswordValid("Ab1á", characters), "a and á are the same letter")
+		assert.False(t, isPasswordValid("bcd1", characters), "no vowels")
+	}
+
+	// the family is five code points, including two ZWJs, which are the same character if they're counted on their own
+	family := "ab1👨‍👩‍👧"
+	assert.False(t, isPasswordValid(family, Segments))
+	assert.True(t, isPasswordValid(family, Graphemes))
+}
@@ -0,0 +1,205 @@
+package bcryptauth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 10
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "bcrypt",
+	Solver: solver.SolverFunc(Solve),
+}
+
+func buildDatabase(s input.Section) (map[string][]byte, error) {
+	ret := map[string][]byte{}
+	for i, curr := range s.Lines {
+		parts, err := input.Fields(curr, " ", 2)
+		if err != nil {
+			return nil, solver.AtLine(puzzleNumber, s.LineNumber(i), curr, fmt.Errorf("buildDatabase: expected \"username hash\": %w", err))
+		}
+		ret[parts[0]] = []byte(parts[1])
+	}
+
+	return ret, nil
+}
+
+func getAllNorms(x string) []string {
+	if utf8.RuneCountInString(x) == len(x) {
+		// is all ascii? no variants possible
+		return []string{x}
+	}
+
+	// i just realized this is potentially broken if we are given a completely non-normalized character (such as ậ =
+	// U+0061 + U+0302 + U+0323). We got lucky here, but we should probably also return the form we got in the
+	// result as well if it is not normalized
+	nfc := norm.NFC.String(x)
+	nfd := norm.NFD.String(x)
+
+	// for some characters (like Ω) are the same in both NFC and NFD forms
+	if nfc == nfd {
+		return []string{nfc}
+	} else {
+		return []string{nfc, nfd}
+	}
+}
+
+// GenerateAllNormalizations generates all possible unicode normalizations of a string. In this case, because we're checking
+// passwords, we can't detect a correct password by normalizing what we have because all we have is a hash we can not reverse.
+// so generate every potential combination of normalized rune in the string. This will produce 2^n strings as output,
+// where n is the number of potentially denormalized runes
+func GenerateAllNormalizations(x string) []string {
+	var chars []string
+	for _, curr := range x {
+		chars = append(chars, string(curr))
+	}
+
+	// this generates all possible normalization variants....for example the string "brûlée" will output
+	// [][]string{[]string{"b"}, []string{"r"}, []string{"û", "û"}, []string{"l"}, []string{"é", "é"}, []string{"e"}}
+	// where each element of the slice is a slice of possible unicode normalizations for that character (note that above,
+	// û and û appear the same, but they have different normalization forms
+	var allVariants [][]string
+	for _, curr := range chars {
+		variants := getAllNorms(curr)
+		allVariants = append(allVariants, variants)
+	}
+
+	return generateCombinations(allVariants, 0, "")
+}
+
+func generateCombinations(variants [][]string, idx int, curr string) []string {
+	if idx == len(variants) {
+		return []string{curr}
+	}
+
+	var res []string
+	for _, variant := range variants[idx] {
+		combos := generateCombinations(variants, idx+1, curr+variant)
+		res = append(res, combos...)
+	}
+	return res
+}
+
+// loginChecker checks login attempts against the database. bcrypt is slow, so once we know a user's correct password we
+// remember it and skip the hashing for the rest of their attempts
+type loginChecker struct {
+	db map[string][]byte
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+func newLoginChecker(db map[string][]byte) *loginChecker {
+	return &loginChecker{
+		db:    db,
+		cache: map[string]string{},
+	}
+}
+
+func (lc *loginChecker) validLogin(entry string) bool {
+	parts := strings.Split(entry, " ")
+
+	username := parts[0]
+	n := norm.NFC.String(parts[1])
+
+	lc.mu.Lock()
+	cached := lc.cache[username]
+	lc.mu.Unlock()
+	if cached == n {
+		return true
+	} else if cached != "" {
+		return false
+	}
+
+	hash := lc.db[username]
+	if hash == nil {
+		return false
+	}
+
+	passwordPotentials := GenerateAllNormalizations(n)
+
+	for _, curr := range passwordPotentials {
+		if err := bcrypt.CompareHashAndPassword(hash, []byte(curr)); err == nil {
+			lc.mu.Lock()
+			lc.cache[username] = n
+			lc.mu.Unlock()
+			return true
+		}
+	}
+
+	return false
+}
+
+func (lc *loginChecker) workerRoutine(jobs <-chan string, results chan<- bool) {
+	for curr := range jobs {
+		results <- lc.validLogin(curr)
+	}
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	in, err := solver.ReadString(r)
+	if err != nil {
+		return "", err
+	}
+
+	sections, err := input.SectionsN(in, 2)
+	if err != nil {
+		return "", solver.Errorf(puzzleNumber, "%w", err)
+	}
+
+	db, err := buildDatabase(sections[0])
+	if err != nil {
+		return "", err
+	}
+	lc := newLoginChecker(db)
+
+	attempts := sections[1].Lines
+	for i, curr := range attempts {
+		if _, err := input.Fields(curr, " ", 2); err != nil {
+			return "", solver.AtLine(puzzleNumber, sections[1].LineNumber(i), curr, fmt.Errorf("expected \"username password\": %w", err))
+		}
+	}
+	logger := solver.Logger(ctx)
+	logger.Debug("read attempts", "attempts", len(attempts))
+
+	jobs := make(chan string, len(attempts))
+	results := make(chan bool, len(attempts))
+
+	numWorkers := runtime.NumCPU()
+	for i := 0; i < numWorkers; i++ {
+		go lc.workerRoutine(jobs, results)
+	}
+
+	logger.Debug("spawned workers", "workers", numWorkers)
+
+	for _, curr := range attempts {
+		jobs <- curr
+	}
+	close(jobs)
+
+	valid := 0
+	for range len(attempts) {
+		if <-results {
+			valid++
+		}
+	}
+
+	return solver.Answerf("%d", valid), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
@@ -0,0 +1,78 @@
+package odysseus
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 11
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "odysseus",
+	Solver: solver.SolverFunc(Solve),
+}
+
+var odysseusNames = []string{"ΟΔΥΣΣΕΥΣ", "ΟΔΥΣΣΕΩΣ", "ΟΔΥΣΣΕΙ", "ΟΔΥΣΣΕΑ", "ΟΔΥΣΣΕΥ"}
+
+var greekUppercase = []rune("ΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΣΤΥΦΧΨΩ")
+
+func rotateCharacter(x rune) rune {
+	idx := slices.Index(greekUppercase, x)
+	if idx == -1 {
+		return x
+	}
+
+	return greekUppercase[(idx+1)%len(greekUppercase)]
+}
+
+func rotateString(x string) string {
+	var sb strings.Builder
+	for _, curr := range x {
+		// strings.Builder never returns an error from WriteRune
+		sb.WriteRune(rotateCharacter(curr))
+	}
+	return sb.String()
+}
+
+func stringContainsOdysseus(x string) bool {
+	for _, curr := range odysseusNames {
+		if strings.Contains(x, curr) {
+			return true
+		}
+	}
+	return false
+}
+
+func odysseusFound(x string) int {
+	x = strings.ToUpper(x)
+	for r := range len(greekUppercase) {
+		if stringContainsOdysseus(x) {
+			return r
+		}
+		x = rotateString(x)
+	}
+	return 0
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	lines, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+
+	total := 0
+	for _, curr := range lines {
+		total += odysseusFound(curr)
+	}
+
+	return solver.Answerf("%d", total), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
@@ -0,0 +1,220 @@
+package phonebook
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 12
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "phone-book",
+	Solver: solver.SolverFunc(Solve),
+}
+
+var entryRegex = regexp.MustCompile(`(.*), (.*): (\d+)`)
+
+var englishReplacer = strings.NewReplacer(
+	"Æ", "AE",
+	"Ø", "O",
+)
+
+var swedishReplacer = strings.NewReplacer(
+	"Æ", "Ä",
+	"Ø", "Ö",
+)
+
+type Entry struct {
+	LastName  string
+	FirstName string
+	Phone     string
+
+	englishNormalizedKey []string
+	swedishNormalizedKey []string
+	dutchNormalizedKey   []string
+}
+
+func generateDutchKey(lastName, firstName string) []string {
+	var fnb strings.Builder
+	var lnb strings.Builder
+
+	ln := norm.NFKD.String(lastName)
+	fn := norm.NFKD.String(firstName)
+
+	ln = englishReplacer.Replace(ln)
+	fn = englishReplacer.Replace(fn)
+
+	upperFound := false
+
+	for _, curr := range ln {
+		if unicode.IsLetter(curr) {
+			if unicode.IsUpper(curr) {
+				upperFound = true
+			}
+
+			if upperFound {
+				lnb.WriteRune(unicode.ToUpper(curr))
+			}
+		}
+	}
+	for _, curr := range fn {
+		if unicode.IsLetter(curr) {
+			fnb.WriteRune(unicode.ToUpper(curr))
+		}
+	}
+
+	return []string{lnb.String(), fnb.String()}
+}
+
+func generateSwedishKey(lastName, firstName string) []string {
+	var fnb strings.Builder
+	var lnb strings.Builder
+
+	ln := norm.NFC.String(lastName)
+	fn := norm.NFC.String(firstName)
+
+	ln = swedishReplacer.Replace(ln)
+	fn = swedishReplacer.Replace(fn)
+
+	for _, curr := range ln {
+		if unicode.IsLetter(curr) {
+			lnb.WriteRune(unicode.ToUpper(curr))
+		}
+	}
+	for _, curr := range fn {
+		if unicode.IsLetter(curr) {
+			fnb.WriteRune(unicode.ToUpper(curr))
+		}
+	}
+
+	return []string{lnb.String(), fnb.String()}
+}
+
+func generateEnglishKey(lastName, firstName string) []string {
+	var fnb strings.Builder
+	var lnb strings.Builder
+
+	ln := norm.NFKD.String(lastName)
+	fn := norm.NFKD.String(firstName)
+
+	ln = englishReplacer.Replace(ln)
+	fn = englishReplacer.Replace(fn)
+
+	for _, curr := range ln {
+		if unicode.IsLetter(curr) {
+			lnb.WriteRune(unicode.ToUpper(curr))
+		}
+	}
+	for _, curr := range fn {
+		if unicode.IsLetter(curr) {
+			fnb.WriteRune(unicode.ToUpper(curr))
+		}
+	}
+
+	return []string{lnb.String(), fnb.String()}
+}
+
+func NewEntry(x string) (*Entry, error) {
+	matches := entryRegex.FindStringSubmatch(x)
+	if matches == nil {
+		return nil, fmt.Errorf("NewEntry: expected \"Last Name, First Name: phone number\"")
+	}
+
+	return &Entry{
+		LastName:  matches[1],
+		FirstName: matches[2],
+		Phone:     matches[3],
+
+		englishNormalizedKey: generateEnglishKey(matches[1], matches[2]),
+		swedishNormalizedKey: generateSwedishKey(matches[1], matches[2]),
+		dutchNormalizedKey:   generateDutchKey(matches[1], matches[2]),
+	}, nil
+}
+
+func middleNumber(entries []*Entry) (int, error) {
+	mid := len(entries) / 2
+	p, err := strconv.Atoi(entries[mid].Phone)
+	if err != nil {
+		return 0, fmt.Errorf("middleNumber: invalid phone number for %s, %s: %w", entries[mid].LastName, entries[mid].FirstName, err)
+	}
+	return p, nil
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	lines, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+
+	if len(lines) == 0 {
+		return "", solver.Errorf(puzzleNumber, "no entries in phone book")
+	}
+
+	entries := make([]*Entry, len(lines))
+	for i := range lines {
+		entries[i], err = NewEntry(lines[i])
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, i+1, lines[i], err)
+		}
+	}
+
+	englishSorted := make([]*Entry, len(entries))
+	copy(englishSorted, entries)
+
+	sort.Slice(englishSorted, func(i, j int) bool {
+		if englishSorted[i].englishNormalizedKey[0] != englishSorted[j].englishNormalizedKey[0] {
+			return englishSorted[i].englishNormalizedKey[0] < englishSorted[j].englishNormalizedKey[0]
+		}
+		return englishSorted[i].englishNormalizedKey[1] < englishSorted[j].englishNormalizedKey[1]
+	})
+
+	swedishSorted := make([]*Entry, len(entries))
+	copy(swedishSorted, entries)
+
+	swedish := collate.New(language.Swedish)
+
+	sort.Slice(swedishSorted, func(i, j int) bool {
+		if swedish.CompareString(swedishSorted[i].swedishNormalizedKey[0], swedishSorted[j].swedishNormalizedKey[0]) != 0 {
+			return swedish.CompareString(swedishSorted[i].swedishNormalizedKey[0], swedishSorted[j].swedishNormalizedKey[0]) < 0
+		}
+		return swedish.CompareString(swedishSorted[i].swedishNormalizedKey[1], swedishSorted[j].swedishNormalizedKey[1]) < 0
+	})
+
+	dutchSorted := make([]*Entry, len(entries))
+	copy(dutchSorted, entries)
+
+	sort.Slice(dutchSorted, func(i, j int) bool {
+		if dutchSorted[i].dutchNormalizedKey[0] != dutchSorted[j].dutchNormalizedKey[0] {
+			return dutchSorted[i].dutchNormalizedKey[0] < dutchSorted[j].dutchNormalizedKey[0]
+		}
+		return dutchSorted[i].dutchNormalizedKey[1] < dutchSorted[j].dutchNormalizedKey[1]
+	})
+
+	ans := 1
+	for _, sorted := range [][]*Entry{englishSorted, swedishSorted, dutchSorted} {
+		mid, err := middleNumber(sorted)
+		if err != nil {
+			return "", solver.Errorf(puzzleNumber, "%w", err)
+		}
+		ans *= mid
+	}
+
+	return solver.Answerf("%d", ans), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
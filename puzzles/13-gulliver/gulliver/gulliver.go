@@ -0,0 +1,175 @@
+package gulliver
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	unidecode "golang.org/x/text/encoding/unicode"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 13
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "gulliver",
+	Solver: solver.SolverFunc(Solve),
+}
+
+var (
+	UTF8ByteOrderMark              = []byte{0xEF, 0xBB, 0xBF}
+	UTF16BigEndianByteOrderMark    = []byte{0xFE, 0xFF}
+	UTF16LittleEndianByteOrderMark = []byte{0xFF, 0xFE}
+)
+
+// all possible decoders
+var decoders = []*encoding.Decoder{
+	charmap.ISO8859_1.NewDecoder(),
+	unidecode.UTF16(unidecode.BigEndian, unidecode.IgnoreBOM).NewDecoder(),
+	unidecode.UTF16(unidecode.LittleEndian, unidecode.IgnoreBOM).NewDecoder(),
+}
+
+func validBytes(b []byte) bool {
+	if bytes.ContainsRune(b, utf8.RuneError) {
+		return false
+	}
+
+	if !utf8.Valid(b) {
+		return false
+	}
+
+	s := string(b)
+
+	for _, curr := range s {
+		if !unicode.IsLetter(curr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DecodeHex decodes a hex encoded string of unknown encoding. If there is a byte order mark, we trust it, otherwise
+// every decoding that produces nothing but letters is returned
+func DecodeHex(in string) ([]string, error) {
+	b, err := hex.DecodeString(in)
+	if err != nil {
+		// point at the first character that isn't hex (or the end of the string if the length is odd)
+		col := strings.IndexFunc(in, func(r rune) bool {
+			return !strings.ContainsRune("0123456789abcdefABCDEF", r)
+		})
+		if col == -1 {
+			col = utf8.RuneCountInString(in)
+		} else {
+			col = utf8.RuneCountInString(in[:col]) + 1
+		}
+		return nil, solver.AtColumn(col, fmt.Errorf("DecodeHex: %w", err))
+	}
+
+	// detect and handle strings with byte order marks. If we have a byte order mark, we know exactly what we're dealing
+	// with
+	if bytes.HasPrefix(b, UTF8ByteOrderMark) {
+		return []string{string(b[3:])}, nil
+	}
+
+	if bytes.HasPrefix(b, UTF16BigEndianByteOrderMark) {
+		dec := unidecode.UTF16(unidecode.BigEndian, unidecode.ExpectBOM).NewDecoder()
+		d, err := dec.Bytes(b)
+		if err != nil {
+			return nil, fmt.Errorf("DecodeHex: could not decode UTF-16BE: %w", err)
+		}
+		return []string{string(d)}, nil
+	}
+
+	if bytes.HasPrefix(b, UTF16LittleEndianByteOrderMark) {
+		dec := unidecode.UTF16(unidecode.LittleEndian, unidecode.ExpectBOM).NewDecoder()
+		d, err := dec.Bytes(b)
+		if err != nil {
+			return nil, fmt.Errorf("DecodeHex: could not decode UTF-16LE: %w", err)
+		}
+		return []string{string(d)}, nil
+	}
+
+	// if we DON'T have a byte order mark, then that means we have to just try everything and go on ~vibes~
+	var ret []string
+
+	if validBytes(b) {
+		ret = append(ret, string(b))
+	}
+
+	for _, decoder := range decoders {
+		d, err := decoder.Bytes(b)
+		if err != nil {
+			continue
+		}
+
+		if !validBytes(d) {
+			continue
+		}
+
+		ret = append(ret, string(d))
+	}
+
+	return ret, nil
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	in, err := solver.ReadString(r)
+	if err != nil {
+		return "", err
+	}
+
+	sections, err := input.SectionsN(in, 2)
+	if err != nil {
+		return "", solver.Errorf(puzzleNumber, "%w", err)
+	}
+	words, crosswordInputs := sections[0], sections[1]
+
+	crosswords := make([]*regexp.Regexp, len(crosswordInputs.Lines))
+	for i, curr := range crosswordInputs.Lines {
+		crosswords[i], err = regexp.Compile(fmt.Sprintf("^%s$", strings.TrimSpace(curr)))
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, crosswordInputs.LineNumber(i), curr, err)
+		}
+	}
+
+	found := map[string]int{}
+
+	for i, curr := range words.Lines {
+		potentials, err := DecodeHex(curr)
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, words.LineNumber(i), curr, err)
+		}
+		for _, potential := range potentials {
+			found[potential] = i + 1
+		}
+	}
+
+	total := 0
+	for _, curr := range crosswords {
+		for word, line := range found {
+			if curr.MatchString(word) {
+				pattern := curr.String()
+				solver.Logger(ctx).Debug("word matches", "word", word, "line", line, "pattern", pattern[1:len(pattern)-1])
+				total += line
+			}
+		}
+	}
+
+	return solver.Answerf("%d", total), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
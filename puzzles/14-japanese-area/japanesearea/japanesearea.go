@@ -0,0 +1,181 @@
+package japanesearea
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 14
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "japanese-area",
+	Solver: solver.SolverFunc(Solve),
+}
+
+const (
+	delimiter  = " × "
+	shakuValue = float64(10) / float64(33)
+)
+
+var largeUnits = map[string]int64{
+	"尺": 1,
+	"間": 6,
+	"丈": 10,
+	"町": 360,
+	"里": 12960,
+}
+
+var smallUnits = map[string]float64{
+	"毛": 10000,
+	"厘": 1000,
+	"分": 100,
+	"寸": 10,
+}
+
+var jaNums = map[rune]int64{
+	'一': 1,
+	'二': 2,
+	'三': 3,
+	'四': 4,
+	'五': 5,
+	'六': 6,
+	'七': 7,
+	'八': 8,
+	'九': 9,
+}
+
+var jaTens = map[rune]int64{
+	'十': 10,
+	'百': 100,
+	'千': 1000,
+}
+
+var jaMyriads = map[rune]int64{
+	'万': 10000,
+	'億': 100000000,
+}
+
+func convertToMeters(num int64, unit string) (float64, error) {
+	if lu, ok := largeUnits[unit]; ok {
+		numShaku := num * lu
+		return float64(numShaku) * shakuValue, nil
+	}
+
+	if su, ok := smallUnits[unit]; ok {
+		numShaku := float64(num) / su
+		return numShaku * shakuValue, nil
+	}
+
+	return 0, fmt.Errorf("convertToMeters: %s: unknown unit", unit)
+}
+
+// ParseJapaneseNumber converts a number written with kanji numerals (like 四十二万四十二) in to an integer
+func ParseJapaneseNumber(x string) (int64, error) {
+	var total int64
+
+	var myriadRunning int64
+	var running int64
+
+	col := 0
+	for _, curr := range x {
+		col++
+		if my := jaMyriads[curr]; my != 0 {
+			if running != 0 {
+				myriadRunning += running
+			}
+			total += my * myriadRunning
+			myriadRunning = 0
+			running = 0
+		} else if p10 := jaTens[curr]; p10 != 0 {
+			if running == 0 {
+				running = 1
+			}
+			myriadRunning += running * p10
+			running = 0
+		} else if digit := jaNums[curr]; digit != 0 {
+			running = digit
+		} else {
+			return 0, solver.AtColumn(col, fmt.Errorf("ParseJapaneseNumber: %c: invalid japanese digit", curr))
+		}
+	}
+
+	total += myriadRunning
+	total += running
+
+	return total, nil
+}
+
+// parseLength parses one side of an area (a number followed by a unit) in to meters. startCol is the column the side
+// starts at in the whole line, so errors point at the right place
+func parseLength(x string, startCol int) (float64, error) {
+	runes := []rune(x)
+	if len(runes) < 2 {
+		return 0, solver.AtColumn(startCol, fmt.Errorf("parseLength: %s: expected a number followed by a unit", x))
+	}
+
+	unit := string(runes[len(runes)-1])
+
+	num, err := ParseJapaneseNumber(string(runes[:len(runes)-1]))
+	if ie, ok := err.(*solver.InputError); ok {
+		return 0, solver.AtColumn(startCol+ie.Column-1, ie.Err)
+	}
+	if err != nil {
+		return 0, solver.AtColumn(startCol, err)
+	}
+
+	m, err := convertToMeters(num, unit)
+	if err != nil {
+		return 0, solver.AtColumn(startCol+len(runes)-1, err)
+	}
+
+	return m, nil
+}
+
+func parseArea(x string) (int64, error) {
+	parts := strings.Split(x, delimiter)
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("parseArea: expected two lengths separated by %q", delimiter)
+	}
+
+	a, err := parseLength(parts[0], 1)
+	if err != nil {
+		return 0, err
+	}
+
+	b, err := parseLength(parts[1], utf8.RuneCountInString(parts[0]+delimiter)+1)
+	if err != nil {
+		return 0, err
+	}
+
+	// problem spec says that each area will be an int
+	return int64(math.Round(a * b)), nil
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	lines, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+	var total int64
+
+	for i, curr := range lines {
+		area, err := parseArea(curr)
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, i+1, curr, err)
+		}
+		total += area
+	}
+
+	return solver.Answerf("%d", total), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
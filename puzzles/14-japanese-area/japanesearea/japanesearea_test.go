@@ -0,0 +1,56 @@
+package japanesearea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+func Test_ParseJapaneseNumber(t *testing.T) {
+	t.Run("happy cases", func(t *testing.T) {
+		tests := map[string]int64{
+			"三百":        300,
+			"三百二十一":     321,
+			"四千":        4_000,
+			"五万":        50_000,
+			"九万九千九百九十九": 99_999,
+			"四十二万四十二":   420_042,
+			"九億八千七百六十五万四千三百二十一": 987_654_321,
+		}
+
+		for jaNum, val := range tests {
+			computed, err := ParseJapaneseNumber(jaNum)
+			assert.NoError(t, err)
+			assert.Equal(t, val, computed)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		_, err := ParseJapaneseNumber("三百二x十一")
+		assert.Error(t, err)
+	})
+}
+
+func Test_parseArea(t *testing.T) {
+	t.Run("column of a bad digit", func(t *testing.T) {
+		_, err := parseArea("三百尺 × 二x十尺")
+		var ie *solver.InputError
+		require.ErrorAs(t, err, &ie)
+		assert.Equal(t, 8, ie.Column)
+	})
+
+	t.Run("unknown unit", func(t *testing.T) {
+		_, err := parseArea("三百尺 × 二十x")
+		var ie *solver.InputError
+		require.ErrorAs(t, err, &ie)
+		assert.Equal(t, 9, ie.Column)
+	})
+
+	t.Run("missing delimiter", func(t *testing.T) {
+		_, err := parseArea("三百尺")
+		assert.Error(t, err)
+	})
+}
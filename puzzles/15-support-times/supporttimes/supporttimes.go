@@ -0,0 +1,248 @@
+package supporttimes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 15
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "support-times",
+	Solver: solver.SolverFunc(Solve),
+}
+
+type Holiday struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+func (h *Holiday) IsDate(when time.Time) bool {
+	return h.Year == when.Year() && h.Month == when.Month() && h.Day == when.Day()
+}
+
+func (h *Holiday) String() string {
+	return fmt.Sprintf("%04d %s %02d", h.Year, h.Month, h.Day)
+}
+
+type TOPlapOffice struct {
+	Name     string
+	TimeZone *time.Location
+	Holidays []Holiday
+}
+
+func (t *TOPlapOffice) IsOpen(when time.Time) bool {
+	officeTime := when.In(t.TimeZone)
+
+	// is it a holiday?
+	for _, curr := range t.Holidays {
+		if curr.IsDate(officeTime) {
+			return false
+		}
+	}
+
+	officeWeekday := officeTime.Weekday()
+	if officeWeekday == time.Saturday || officeWeekday == time.Sunday {
+		return false
+	}
+
+	openingTime := time.Date(officeTime.Year(), officeTime.Month(), officeTime.Day(), 8, 29, 0, 0, officeTime.Location())
+	closingTime := time.Date(officeTime.Year(), officeTime.Month(), officeTime.Day(), 17, 00, 0, 0, officeTime.Location())
+
+	return officeTime.After(openingTime) && officeTime.Before(closingTime)
+}
+
+func (t *TOPlapOffice) String() string {
+	holidayStrings := make([]string, len(t.Holidays))
+	for i := range t.Holidays {
+		holidayStrings[i] = t.Holidays[i].String()
+	}
+
+	return fmt.Sprintf("%s (TZ = %s). Holidays = %s", t.Name, t.TimeZone.String(), strings.Join(holidayStrings, ","))
+}
+
+func anyOfficeOpen(offices []*TOPlapOffice, when time.Time) bool {
+	for _, curr := range offices {
+		if curr.IsOpen(when) {
+			return true
+		}
+	}
+	return false
+}
+
+func isInOfficeHolidays(holidays []Holiday, when time.Time) bool {
+	for _, curr := range holidays {
+		if curr.IsDate(when) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func overtimeNeeded(offices []*TOPlapOffice, x string) (int, error) {
+	// surely you will not regret iterating minute-by-minute for the whole year
+	//
+	// "Technically, it's O(1) because 2022 is always 525600 minutes!" -- Me, trying to justify my bad decisions
+
+	overtimeMinutes := 0
+
+	fields, err := splitOfficeLine(x)
+	if err != nil {
+		return 0, err
+	}
+	officeZone, err := time.LoadLocation(fields[1])
+	if err != nil {
+		return 0, fmt.Errorf("overtimeNeeded: could not load time zone: %w", err)
+	}
+	officeHolidays, err := decodeHolidays(fields[2])
+	if err != nil {
+		return 0, err
+	}
+
+	currTime := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2022, time.December, 31, 23, 59, 0, 0, time.UTC)
+
+	minsChecked := 0
+	for currTime.Before(endTime) {
+		officeTime := currTime.In(officeZone)
+		minsChecked++
+		if isInOfficeHolidays(officeHolidays, officeTime) {
+			currTime = currTime.Add(1 * time.Minute)
+			continue
+		}
+
+		officeWeekday := officeTime.Weekday()
+		if officeWeekday == time.Saturday || officeWeekday == time.Sunday {
+			currTime = currTime.Add(1 * time.Minute)
+			continue
+		}
+
+		if !anyOfficeOpen(offices, currTime) {
+			overtimeMinutes++
+		}
+
+		currTime = currTime.Add(1 * time.Minute)
+	}
+
+	return overtimeMinutes, nil
+}
+
+// splitOfficeLine splits a line describing an office in to its name, time zone and holidays
+func splitOfficeLine(x string) ([]string, error) {
+	fields, err := input.TabFields(x, 3)
+	if err != nil {
+		return nil, fmt.Errorf("splitOfficeLine: %w", err)
+	}
+
+	return fields, nil
+}
+
+func decodeHolidays(x string) ([]Holiday, error) {
+	unparsedHolidays := strings.Split(x, ";")
+	holidays := make([]Holiday, len(unparsedHolidays))
+
+	for i := range unparsedHolidays {
+		date, err := time.Parse("2 January 2006", unparsedHolidays[i])
+		if err != nil {
+			return nil, fmt.Errorf("decodeHolidays: could not parse holiday: %w", err)
+		}
+		holidays[i] = Holiday{
+			Year:  date.Year(),
+			Month: date.Month(),
+			Day:   date.Day(),
+		}
+	}
+
+	return holidays, nil
+}
+
+func NewTOPLapOffice(x string) (*TOPlapOffice, error) {
+	fields, err := splitOfficeLine(x)
+	if err != nil {
+		return nil, err
+	}
+	name := fields[0]
+	timeZone, err := time.LoadLocation(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("NewTOPLapOffice: could not load time zone: %w", err)
+	}
+
+	holidays, err := decodeHolidays(fields[2])
+	if err != nil {
+		return nil, err
+	}
+
+	return &TOPlapOffice{
+		Name:     name,
+		TimeZone: timeZone,
+		Holidays: holidays,
+	}, nil
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	in, err := solver.ReadString(r)
+	if err != nil {
+		return "", err
+	}
+
+	sections, err := input.SectionsN(in, 2)
+	if err != nil {
+		return "", solver.Errorf(puzzleNumber, "expected TOPlap offices and customer offices: %w", err)
+	}
+
+	var toplapOffices []*TOPlapOffice
+
+	for i, curr := range sections[0].Lines {
+		office, err := NewTOPLapOffice(curr)
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, sections[0].LineNumber(i), curr, err)
+		}
+		toplapOffices = append(toplapOffices, office)
+	}
+
+	customerOfficeLines := sections[1].Lines
+
+	var wg sync.WaitGroup
+	wg.Add(len(customerOfficeLines))
+
+	overtimeOffices := make([]int, len(customerOfficeLines))
+	overtimeErrors := make([]error, len(customerOfficeLines))
+	for i := range customerOfficeLines {
+		go func() {
+			defer wg.Done()
+			mins, err := overtimeNeeded(toplapOffices, customerOfficeLines[i])
+			if err != nil {
+				overtimeErrors[i] = solver.AtLine(puzzleNumber, sections[1].LineNumber(i), customerOfficeLines[i], err)
+				return
+			}
+			overtimeOffices[i] = mins
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(overtimeErrors...); err != nil {
+		return "", err
+	}
+
+	minReqd := slices.Min(overtimeOffices)
+	maxReqd := slices.Max(overtimeOffices)
+
+	return solver.Answerf("%d", maxReqd-minReqd), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
@@ -1,4 +1,4 @@
-package main
+package pipes
 
 import (
 	"testing"
@@ -15,7 +15,7 @@ func Test_Pipe(t *testing.T) {
 		assert.Equal(t, uint8(2), p.edges.Left())
 		assert.Equal(t, uint8(0), p.edges.Right())
 
-		p.Rotate()
+		assert.NoError(t, p.Rotate())
 
 		assert.Equal(t, uint8(2), p.edges.Up())
 		assert.Equal(t, uint8(0), p.edges.Down())
@@ -39,6 +39,12 @@ func Test_Pipe(t *testing.T) {
 		assert.False(t, l.locked)
 	})
 
+	t.Run("locked pipes can not rotate", func(t *testing.T) {
+		x := NewPipe('╬')
+		assert.Error(t, x.Rotate())
+		assert.Equal(t, '╬', x.char)
+	})
+
 	t.Run("test matches", func(t *testing.T) {
 		e := runeMap['│']
 
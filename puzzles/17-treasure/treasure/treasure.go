@@ -0,0 +1,412 @@
+package treasure
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 17
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "treasure",
+	Solver: solver.SolverFunc(Solve),
+}
+
+const (
+	theX     = '╳'
+	nullByte = byte(0)
+)
+
+var (
+	LeftEdges = []string{"╔", "|", "║", "╚"}
+)
+
+type ByteKind int
+
+const (
+	SingleByteCodePoint ByteKind = iota
+	TwoByteHeader
+	ThreeByteHeader
+	FourByteHeader
+	ContinuationByte
+
+	// InvalidByte is a byte that can never appear in UTF-8 (0xF8 through 0xFF)
+	InvalidByte
+)
+
+func detectByteKind(x byte) ByteKind {
+	if x&0x80 == 0 {
+		return SingleByteCodePoint
+	}
+
+	if x&0xE0 == 0xC0 {
+		return TwoByteHeader
+	}
+
+	if x&0xF0 == 0xE0 {
+		return ThreeByteHeader
+	}
+
+	if x&0xF8 == 0xF0 {
+		return FourByteHeader
+	}
+
+	if x&0xC0 == 0x80 {
+		return ContinuationByte
+	}
+
+	return InvalidByte
+}
+
+type Chunk struct {
+	hexInput []string
+	input    []string
+	lines    [][]byte
+
+	bytesMissingAtEnd       []int
+	bytesMissingAtBeginning []int
+
+	used bool
+}
+
+func (c *Chunk) meshesRightWithEdge(edge []int) bool {
+	toCheck := min(len(edge), len(c.bytesMissingAtEnd))
+	for i := 0; i < toCheck; i++ {
+		if edge[i] != c.bytesMissingAtEnd[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Chunk) meshesLeftWithEdge(edge []int) bool {
+	//return slices.Equal(c.bytesMissingAtBeginning[:len(edge)], edge)
+	toCheck := min(len(edge), len(c.bytesMissingAtBeginning))
+	for i := 0; i < toCheck; i++ {
+		if edge[i] != c.bytesMissingAtBeginning[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Chunk) isTopEdge() bool {
+	return strings.Contains(c.input[0], "-═") || strings.Contains(c.input[0], "╔") || strings.Contains(c.input[0], "╗")
+}
+
+func (c *Chunk) isLeftEdge() bool {
+	for _, curr := range c.input {
+		for _, r := range LeftEdges {
+			if strings.HasPrefix(curr, r) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func detectDanglingContinuationBytes(x []byte) int {
+	continuationBytesFound := 0
+	for i := range x {
+		if detectByteKind(x[i]) == ContinuationByte {
+			continuationBytesFound++
+		} else {
+			break
+		}
+	}
+
+	return continuationBytesFound
+}
+
+func detectEndBytesMissing(x []byte) (int, error) {
+	continuationBytesFound := 0
+
+	var i int
+	for i = len(x) - 1; i >= 0; i-- {
+		if detectByteKind(x[i]) != ContinuationByte {
+			break
+		}
+		continuationBytesFound++
+	}
+
+	if i < 0 {
+		return 0, fmt.Errorf("detectEndBytesMissing: line is entirely continuation bytes")
+	}
+
+	bk := detectByteKind(x[i])
+	switch bk {
+	case SingleByteCodePoint:
+		if continuationBytesFound > 0 {
+			return 0, solver.AtColumn(i+2, fmt.Errorf("detectEndBytesMissing: continuation bytes found after single byte code point"))
+		}
+		return 0, nil
+	case TwoByteHeader:
+		return 1 - continuationBytesFound, nil
+	case ThreeByteHeader:
+		return 2 - continuationBytesFound, nil
+	case FourByteHeader:
+		return 3 - continuationBytesFound, nil
+	default:
+		return 0, solver.AtColumn(i+1, fmt.Errorf("detectEndBytesMissing: 0x%02X: invalid UTF-8 byte", x[i]))
+	}
+
+}
+
+// NewChunk decodes one blank line separated section of the input. Errors are reported at the section's line numbers in
+// the whole input
+func NewChunk(s input.Section) (Chunk, error) {
+
+	var b [][]byte
+	var endBytesMissing []int
+	var beginBytesMissing []int
+
+	var rawLines []string
+
+	for i, curr := range s.Lines {
+		lineBytes, err := hex.DecodeString(curr)
+		if err != nil {
+			return Chunk{}, solver.AtLine(puzzleNumber, s.LineNumber(i), curr, fmt.Errorf("NewChunk: could not decode hex: %w", err))
+		}
+
+		endEdge, err := detectEndBytesMissing(lineBytes)
+		if ie, ok := err.(*solver.InputError); ok && ie.Column > 0 {
+			// the column is a byte offset, but the line is in hex, which is two characters a byte
+			err = solver.AtColumn(2*ie.Column-1, ie.Err)
+		}
+		if err != nil {
+			return Chunk{}, solver.AtLine(puzzleNumber, s.LineNumber(i), curr, err)
+		}
+		beginEdge := detectDanglingContinuationBytes(lineBytes)
+
+		b = append(b, lineBytes)
+		endBytesMissing = append(endBytesMissing, endEdge)
+		beginBytesMissing = append(beginBytesMissing, beginEdge)
+		rawLines = append(rawLines, string(lineBytes))
+	}
+
+	if len(b) == 0 {
+		return Chunk{}, fmt.Errorf("NewChunk: chunk has no lines")
+	}
+
+	return Chunk{
+		hexInput:                s.Lines,
+		input:                   rawLines,
+		lines:                   b,
+		bytesMissingAtBeginning: beginBytesMissing,
+		bytesMissingAtEnd:       endBytesMissing,
+	}, nil
+}
+
+func puzzleComplete(chunks []*Chunk) bool {
+	unusedChunks := 0
+	for _, curr := range chunks {
+		if !curr.used {
+			unusedChunks++
+		}
+	}
+	return unusedChunks == 0
+}
+
+// logMap logs the assembled map one row at a time
+func logMap(logger *slog.Logger, m [][]byte) {
+	for y := range m {
+		logger.Debug("map", "y", y, "row", string(m[y]))
+	}
+}
+
+func findPuzzleEdge(puzzleLine []byte) (int, bool, error) {
+	for i := 0; i < len(puzzleLine)-1; i++ {
+		currByteNull := puzzleLine[i] == nullByte
+		nextByteNull := puzzleLine[i+1] == nullByte
+
+		// this is essentially an XOR
+		if currByteNull != nextByteNull {
+			goingRight := !currByteNull
+			trueIndex := i
+
+			// the caller of this expects us to return the position of the first null byte, which is the next one in
+			// the case of going left
+			if goingRight {
+				trueIndex += 1
+			}
+			return trueIndex, goingRight, nil
+		}
+	}
+
+	return 0, false, fmt.Errorf("findPuzzleEdge: no edge found")
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	in, err := solver.ReadString(r)
+	if err != nil {
+		return "", err
+	}
+
+	logger := solver.Logger(ctx)
+	chunkInputs := input.Sections(in)
+
+	chunks := make([]*Chunk, len(chunkInputs))
+	for i := range chunkInputs {
+		c, err := NewChunk(chunkInputs[i])
+		if err != nil {
+			return "", err
+		}
+		chunks[i] = &c
+	}
+
+	logger.Debug("found chunks", "chunks", len(chunks))
+
+	var upperLeft *Chunk
+
+	for _, curr := range chunks {
+		for _, l := range curr.lines {
+			if bytes.ContainsRune(l, '╔') {
+				upperLeft = curr
+			}
+		}
+	}
+
+	if upperLeft == nil {
+		return "", solver.Errorf(puzzleNumber, "no chunk contains the upper left corner of the map")
+	}
+
+	height := 0
+	width := 0
+	for _, curr := range chunks {
+		if curr.isLeftEdge() {
+			height += len(curr.lines)
+		}
+		if curr.isTopEdge() {
+			width += len(curr.lines[0]) // need number of BYTES not runes
+		}
+	}
+
+	logger.Debug("found map size", "height", height, "width", width)
+
+	var puzzle [][]byte
+
+	for i := 0; i < height; i++ {
+		line := make([]byte, width)
+		puzzle = append(puzzle, line)
+	}
+
+	// seed with upper left corner
+	for y, l := range upperLeft.lines {
+		for x, c := range l {
+			puzzle[y][x] = c
+		}
+	}
+	upperLeft.used = true
+
+	for !puzzleComplete(chunks) {
+		// find the top-left most edge that has not been completed
+		y := slices.IndexFunc(puzzle, func(i []byte) bool {
+			return slices.Contains(i, nullByte)
+		})
+
+		// now find the x offset to go
+		x, goingRight, err := findPuzzleEdge(puzzle[y])
+		if err != nil {
+			return "", solver.Errorf(puzzleNumber, "row %d: %w", y, err)
+		}
+
+		var edges []int
+		currY := y
+		// now find the height we want
+		for {
+			if currY > len(puzzle)-1 {
+				break
+			}
+			if goingRight && puzzle[currY][x-1] != nullByte {
+				missing, err := detectEndBytesMissing(puzzle[currY][:x])
+				if err != nil {
+					return "", solver.Errorf(puzzleNumber, "row %d: %w", currY, err)
+				}
+				edges = append(edges, missing)
+				currY++
+			} else if !goingRight && puzzle[currY][x+1] != nullByte {
+				edges = append(edges, detectDanglingContinuationBytes(puzzle[currY][x+1:]))
+				currY++
+			} else {
+				break
+			}
+		}
+
+		// now that we have an edge to solve, go find one
+		foundChunkIdx := slices.IndexFunc(chunks, func(chunk *Chunk) bool {
+			if chunk.used {
+				return false
+			}
+			if goingRight {
+				return chunk.meshesLeftWithEdge(edges)
+			} else {
+				return chunk.meshesRightWithEdge(edges)
+			}
+		})
+
+		if foundChunkIdx == -1 {
+			return "", solver.Errorf(puzzleNumber, "no unused chunk fits the edge at (%d, %d)", x, y)
+		}
+
+		foundChunk := chunks[foundChunkIdx]
+
+		if goingRight {
+			for chunkLineNum, chunkLine := range foundChunk.lines {
+				for chunkByteIdx, chunkByte := range chunkLine {
+					puzzle[y+chunkLineNum][x+chunkByteIdx] = chunkByte
+				}
+			}
+		} else {
+			x = x - len(foundChunk.lines[0]) + 1
+			for chunkLineNum, chunkLine := range foundChunk.lines {
+				for chunkByteIdx, chunkByte := range chunkLine {
+					puzzle[y+chunkLineNum][x+chunkByteIdx] = chunkByte
+				}
+			}
+		}
+
+		foundChunk.used = true
+	}
+
+	mapStrings := make([]string, len(puzzle))
+	for i := range puzzle {
+		mapStrings[i] = string(puzzle[i])
+	}
+
+	treasureX := slices.IndexFunc(mapStrings, func(s string) bool {
+		return strings.ContainsRune(s, theX)
+	})
+
+	if treasureX == -1 {
+		return "", solver.Errorf(puzzleNumber, "assembled map has no %c on it", theX)
+	}
+
+	var treasureY int
+	for _, c := range mapStrings[treasureX] {
+		if c == theX {
+			break
+		}
+		treasureY++
+	}
+
+	logMap(logger, puzzle)
+
+	return solver.Answerf("%d", treasureY*treasureX), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
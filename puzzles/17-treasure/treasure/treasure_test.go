@@ -0,0 +1,94 @@
+package treasure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+func Test_ByteKind(t *testing.T) {
+	assert.Equal(t, SingleByteCodePoint, detectByteKind(0x32))
+
+	assert.Equal(t, TwoByteHeader, detectByteKind(0b11011000))
+	assert.Equal(t, TwoByteHeader, detectByteKind(0b11011111))
+	assert.Equal(t, TwoByteHeader, detectByteKind(0b11000000))
+
+	assert.Equal(t, ThreeByteHeader, detectByteKind(0b11101101))
+	assert.Equal(t, ThreeByteHeader, detectByteKind(0b11100000))
+	assert.Equal(t, ThreeByteHeader, detectByteKind(0b11101111))
+
+	assert.Equal(t, FourByteHeader, detectByteKind(0b11110101))
+	assert.Equal(t, FourByteHeader, detectByteKind(0b11110000))
+	assert.Equal(t, FourByteHeader, detectByteKind(0b11110111))
+
+	assert.Equal(t, ContinuationByte, detectByteKind(0b10101010))
+	assert.Equal(t, ContinuationByte, detectByteKind(0b10111111))
+	assert.Equal(t, ContinuationByte, detectByteKind(0b10000000))
+	assert.Equal(t, ContinuationByte, detectByteKind(0b10110101))
+
+	assert.Equal(t, InvalidByte, detectByteKind(0xF8))
+	assert.Equal(t, InvalidByte, detectByteKind(0xFF))
+}
+
+func assertEndBytesMissing(t *testing.T, expected int, x []byte) {
+	t.Helper()
+
+	missing, err := detectEndBytesMissing(x)
+	require.NoError(t, err)
+	assert.Equal(t, expected, missing)
+}
+
+func Test_EndBytesMissing(t *testing.T) {
+	assertEndBytesMissing(t, 0, []byte{0x0A, 0x0B, 0x65, 0x11})
+	assertEndBytesMissing(t, 0, []byte{0x0A, 0x0B, 0xC0, 0x8F})
+	assertEndBytesMissing(t, 0, []byte{0x0A, 0xE0, 0x8F, 0x8F})
+	assertEndBytesMissing(t, 0, []byte{0xF0, 0x8F, 0x8F, 0x8F})
+
+	assertEndBytesMissing(t, 1, []byte{0x0A, 0x0B, 0x0A, 0xC0})
+	assertEndBytesMissing(t, 2, []byte{0x0A, 0x0B, 0x0A, 0xE0})
+	assertEndBytesMissing(t, 3, []byte{0x0A, 0x0B, 0x0A, 0xF0})
+
+	assertEndBytesMissing(t, 1, []byte{0x0A, 0x0B, 0xE0, 0x8F})
+	assertEndBytesMissing(t, 2, []byte{0x0A, 0x0B, 0xF0, 0x8F})
+
+	assertEndBytesMissing(t, 1, []byte{0x00, 0xF0, 0x8F, 0x8F})
+
+	t.Run("invalid lines", func(t *testing.T) {
+		_, err := detectEndBytesMissing([]byte{0x8F, 0x8F})
+		assert.Error(t, err)
+
+		_, err = detectEndBytesMissing([]byte{0x0A, 0x41, 0x8F})
+		assert.Error(t, err)
+
+		_, err = detectEndBytesMissing([]byte{0x0A, 0xFF})
+		assert.Error(t, err)
+	})
+}
+
+func Test_NewChunk(t *testing.T) {
+	t.Run("bad hex", func(t *testing.T) {
+		_, err := NewChunk(input.Section{StartLine: 10, Lines: []string{"4142", "41zz"}})
+		var ie *solver.InputError
+		require.ErrorAs(t, err, &ie)
+		assert.Equal(t, puzzleNumber, ie.Puzzle)
+		assert.Equal(t, 11, ie.Line)
+	})
+
+	t.Run("invalid byte", func(t *testing.T) {
+		_, err := NewChunk(input.Section{StartLine: 1, Lines: []string{"4142ff"}})
+		var ie *solver.InputError
+		require.ErrorAs(t, err, &ie)
+		assert.Equal(t, 1, ie.Line)
+		assert.Equal(t, 5, ie.Column)
+	})
+}
+
+func Test_DetectDanglingContinuationBytes(t *testing.T) {
+	assert.Equal(t, 0, detectDanglingContinuationBytes([]byte{0x00, 0x00, 0x00, 0x00}))
+	assert.Equal(t, 1, detectDanglingContinuationBytes([]byte{0x8F, 0x00, 0x00, 0x00}))
+	assert.Equal(t, 2, detectDanglingContinuationBytes([]byte{0x8F, 0x8F, 0x00, 0x00}))
+}
@@ -0,0 +1,172 @@
+package bidimath
+
+import (
+	"context"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 18
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "math",
+	Solver: solver.SolverFunc(Solve),
+}
+
+const (
+	RLIMarker = '\u2067'
+	LRIMarker = '\u2066'
+	PDIMarker = '\u2069'
+)
+
+func stripMarkers(x string) string {
+	var sb strings.Builder
+	for _, curr := range x {
+		if curr == RLIMarker || curr == LRIMarker || curr == PDIMarker {
+			continue
+		}
+		sb.WriteRune(curr)
+	}
+
+	return sb.String()
+}
+
+func findHighestRun(embeddingLevels []int) (int, int, int) {
+	highestLevel := -1
+	highestIdx := -1
+	highestLength := -1
+	inHighest := false
+
+	for i, curr := range embeddingLevels {
+		if curr < highestLevel {
+			inHighest = false
+			continue
+		}
+
+		if inHighest && curr == highestLevel {
+			highestLength++
+			continue
+		}
+
+		if curr > highestLevel {
+			highestLevel = curr
+			highestLength = 1
+			highestIdx = i
+			inHighest = true
+		}
+	}
+
+	return highestLevel, highestIdx, highestLength
+}
+
+// FixReversedString applies the bidi isolate markers (RLI, LRI and PDI) in a string the way a renderer would, returning
+// the string as it actually appears on screen with the markers removed
+func FixReversedString(in string) string {
+	var embeddingLevels []int
+	var chars []rune
+
+	currLevel := 0
+	for _, c := range in {
+		increasedForDigit := false
+		if currLevel%2 == 1 && unicode.IsDigit(c) {
+			currLevel++
+			increasedForDigit = true
+		}
+		chars = append(chars, c)
+		embeddingLevels = append(embeddingLevels, currLevel)
+		if c == RLIMarker && currLevel%2 == 0 {
+			currLevel++
+		} else if c == LRIMarker && currLevel%2 == 1 {
+			currLevel++
+		} else if c == PDIMarker {
+			// overwrite the last one since we wrote alread
+			currLevel--
+			embeddingLevels[len(embeddingLevels)-1] = currLevel
+		}
+		if increasedForDigit {
+			currLevel--
+		}
+	}
+
+	finalRunes := []rune(in)
+
+	highestLevel, highestIdx, highestLength := findHighestRun(embeddingLevels)
+	for highestLevel != 0 {
+		if highestLength == 1 {
+			// trivial case
+			embeddingLevels[highestIdx]--
+			highestLevel, highestIdx, highestLength = findHighestRun(embeddingLevels)
+			continue
+		}
+		runesToReverse := finalRunes[highestIdx : highestIdx+highestLength]
+		slices.Reverse(runesToReverse)
+
+		for i, curr := range runesToReverse {
+			if curr == '(' {
+				runesToReverse[i] = ')'
+			} else if curr == ')' {
+				runesToReverse[i] = '('
+			}
+		}
+
+		var newRuneList []rune
+		for _, c := range finalRunes[:highestIdx] {
+			newRuneList = append(newRuneList, c)
+		}
+		for _, c := range runesToReverse {
+			newRuneList = append(newRuneList, c)
+		}
+		for _, c := range finalRunes[highestIdx+highestLength:] {
+			newRuneList = append(newRuneList, c)
+		}
+
+		finalRunes = newRuneList
+
+		for i := highestIdx; i < highestIdx+highestLength; i++ {
+			embeddingLevels[i] = embeddingLevels[i] - 1
+		}
+
+		highestLevel, highestIdx, highestLength = findHighestRun(embeddingLevels)
+	}
+
+	return stripMarkers(string(finalRunes))
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	in, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+
+	total := float64(0)
+
+	for i, curr := range in {
+		a := stripMarkers(curr)
+		b := FixReversedString(curr)
+
+		// columns from the evaluator are relative to the cleaned up expression, not the raw line, so only the text is
+		// attached here
+		an, err := evalExpression(a)
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, i+1, a, err)
+		}
+		bn, err := evalExpression(b)
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, i+1, b, err)
+		}
+
+		total += math.Abs(an - bn)
+	}
+
+	return solver.Answerf("%.f", total), nil
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
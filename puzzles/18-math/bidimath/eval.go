@@ -0,0 +1,225 @@
+package bidimath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/scanner"
+
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+type RPNTokenKind int
+
+const (
+	TokenKindOperator RPNTokenKind = iota
+	TokenKindOperand
+)
+
+type RPNToken struct {
+	Value any
+	Kind  RPNTokenKind
+}
+
+func (rt *RPNToken) isOperand() bool {
+	return rt.Kind == TokenKindOperand
+}
+
+func (rt *RPNToken) numericValue() (float64, error) {
+	v, ok := rt.Value.(float64)
+	if rt.Kind != TokenKindOperand || !ok {
+		return 0, fmt.Errorf("numericValue: %v: attempted to get number from operator", rt.Value)
+	}
+
+	return v, nil
+}
+
+func (rt *RPNToken) operatorValue() (string, error) {
+	v, ok := rt.Value.(string)
+	if rt.Kind != TokenKindOperator || !ok {
+		return "", fmt.Errorf("operatorValue: %v: attempted to get operator from operand", rt.Value)
+	}
+
+	return v, nil
+}
+
+func (rt *RPNToken) String() string {
+	return fmt.Sprintf("%v", rt.Value)
+}
+
+func newNumberToken(x float64) *RPNToken {
+	return &RPNToken{
+		Kind:  TokenKindOperand,
+		Value: x,
+	}
+}
+
+func newOperatorToken(x string) (*RPNToken, error) {
+	if x != "+" && x != "-" && x != "*" && x != "/" && x != "(" && x != ")" {
+		return nil, fmt.Errorf("newOperatorToken: %s: invalid operator", x)
+	}
+
+	return &RPNToken{
+		Kind:  TokenKindOperator,
+		Value: x,
+	}, nil
+}
+
+func precedence(op string) int {
+	switch op {
+	case "+", "-":
+		return 1
+	case "*", "/":
+		return 2
+	case "^":
+		return 3
+	}
+	return 0
+}
+
+func shuntingYard(tokens []string) ([]*RPNToken, error) {
+	var output []*RPNToken
+	var operators []string
+
+	// popOperator moves the operator on the top of the stack to the output
+	popOperator := func() error {
+		op, err := newOperatorToken(operators[len(operators)-1])
+		if err != nil {
+			return err
+		}
+		output = append(output, op)
+		operators = operators[:len(operators)-1]
+		return nil
+	}
+
+	for _, token := range tokens {
+		if value, err := strconv.ParseFloat(token, 64); err == nil {
+			// token is a number, so add it to the output
+			output = append(output, newNumberToken(value))
+		} else if token == "(" {
+			// open paren has been found, so add that too
+			operators = append(operators, token)
+		} else if token == ")" {
+			// find the matching close
+			for len(operators) > 0 && operators[len(operators)-1] != "(" {
+				if err := popOperator(); err != nil {
+					return nil, err
+				}
+			}
+			if len(operators) == 0 {
+				return nil, fmt.Errorf("shuntingYard: unbalanced parentheses: unexpected )")
+			}
+			operators = operators[:len(operators)-1]
+		} else {
+			if precedence(token) == 0 {
+				return nil, fmt.Errorf("shuntingYard: %s: unknown operator", token)
+			}
+			// operator found, figure out it's precednce and act accordingly
+			for len(operators) > 0 &&
+				operators[len(operators)-1] != "(" &&
+				(precedence(operators[len(operators)-1]) > precedence(token) ||
+					(precedence(operators[len(operators)-1]) == precedence(token))) {
+				if err := popOperator(); err != nil {
+					return nil, err
+				}
+			}
+			operators = append(operators, token)
+		}
+	}
+
+	for len(operators) > 0 {
+		if operators[len(operators)-1] == "(" {
+			return nil, fmt.Errorf("shuntingYard: unbalanced parentheses: missing )")
+		}
+		if err := popOperator(); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func tokenize(input string) ([]string, error) {
+	var s scanner.Scanner
+	s.Init(strings.NewReader(input))
+
+	var scanErr error
+	s.Error = func(s *scanner.Scanner, msg string) {
+		if scanErr == nil {
+			scanErr = solver.AtColumn(s.Position.Column, fmt.Errorf("tokenize: %s", msg))
+		}
+	}
+
+	var tok rune
+	var result = make([]string, 0)
+	for tok != scanner.EOF {
+		tok = s.Scan()
+		value := strings.TrimSpace(s.TokenText())
+		if len(value) > 0 {
+			result = append(result, s.TokenText())
+		}
+	}
+	if scanErr != nil {
+		return nil, scanErr
+	}
+	return result, nil
+}
+
+func evalOperator(op string, a float64, b float64) (float64, error) {
+	switch op {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	case "/":
+		return a / b, nil
+	default:
+		return 0, fmt.Errorf("evalOperator: %s: unknown operator", op)
+	}
+}
+
+func evalExpression(x string) (float64, error) {
+	tokens, err := tokenize(x)
+	if err != nil {
+		return 0, err
+	}
+
+	rpn, err := shuntingYard(tokens)
+	if err != nil {
+		return 0, err
+	}
+
+	var stack []float64
+	for _, token := range rpn {
+		if token.isOperand() {
+			v, err := token.numericValue()
+			if err != nil {
+				return 0, err
+			}
+			stack = append(stack, v)
+		} else {
+			if len(stack) < 2 {
+				return 0, fmt.Errorf("evalExpression: %s needs 2 operands", token)
+			}
+			a, b := stack[len(stack)-2], stack[len(stack)-1]
+			stack = stack[:len(stack)-2]
+			op, err := token.operatorValue()
+			if err != nil {
+				return 0, err
+			}
+			evalRes, err := evalOperator(op, a, b)
+			if err != nil {
+				return 0, err
+			}
+			stack = append(stack, evalRes)
+		}
+	}
+
+	if len(stack) != 1 {
+		return 0, fmt.Errorf("evalExpression: expected 1 item on the stack at the end, got %d", len(stack))
+	}
+
+	return stack[0], nil
+}
@@ -0,0 +1,35 @@
+package bidimath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected float64
+	}{
+		{"5 + 5", 10},
+		{"5 * (6 + 5)", 55},
+		{"5 - 10", -5},
+		{"((1 + 1) + 1) * ((4 - (15 - (66 / 2))) * 1)", 66},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			v, err := evalExpression(tc.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, v)
+		})
+	}
+
+	t.Run("malformed expressions", func(t *testing.T) {
+		for _, curr := range []string{"5 +", "(5 + 5", "5 + 5)", "5 % 5", "5 5", "'5"} {
+			_, err := evalExpression(curr)
+			assert.Error(t, err, curr)
+		}
+	})
+}
@@ -0,0 +1,201 @@
+package oldtz
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+const puzzleNumber = 19
+
+var Puzzle = solver.Puzzle{
+	Number: puzzleNumber,
+	Name:   "old-tz",
+	Solver: solver.SolverFunc(Solve),
+}
+
+const (
+	TimeFormatString = "2006-01-02 15:04:05"
+)
+
+var (
+	zoneinfoRegex = regexp.MustCompile(`^zoneinfo-(\d{4}[a-z])\.tar\.gz$`)
+)
+
+//go:embed tzdata/*
+var tzdata embed.FS
+
+type TzData map[string][]byte
+
+var zonedata = map[string]TzData{}
+
+// loading every tzdata version takes a moment, so only do it if this puzzle is actually being run
+var (
+	zonedataOnce sync.Once
+	zonedataErr  error
+)
+
+func loadZonesFromTarGz(x []byte) (TzData, error) {
+	gzr, err := gzip.NewReader(bytes.NewReader(x))
+	if err != nil {
+		return nil, fmt.Errorf("loadZonesFromTarGz: could not open gzip stream: %w", err)
+	}
+
+	ret := TzData{}
+
+	links := map[string]string{}
+
+	tr := tar.NewReader(gzr)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("loadZonesFromTarGz: could not read tar header: %w", err)
+		}
+
+		if strings.Contains(h.Name, ".") {
+			continue
+		}
+
+		if h.Typeflag == tar.TypeReg {
+			var byf bytes.Buffer
+			_, err = io.Copy(&byf, tr)
+			if err != nil {
+				return nil, fmt.Errorf("loadZonesFromTarGz: %s: could not read zone: %w", h.Name, err)
+			}
+			ret[h.Name] = byf.Bytes()
+		} else if h.Typeflag == tar.TypeLink {
+			// some entries are symlinked, so save those for processing later
+			links[h.Name] = h.Linkname
+		}
+	}
+
+	for k, v := range links {
+		d := make([]byte, len(ret[v]))
+		copy(d, ret[v])
+		ret[k] = d
+	}
+
+	return ret, nil
+}
+
+func loadAllZones(logger *slog.Logger) error {
+	logger.Debug("loading available tz versions")
+	dir, err := tzdata.ReadDir("tzdata")
+	if err != nil {
+		return fmt.Errorf("loadAllZones: could not list embedded tzdata: %w", err)
+	}
+	for _, curr := range dir {
+		m := zoneinfoRegex.FindStringSubmatch(curr.Name())
+		if m != nil {
+
+			f, err := tzdata.ReadFile(fmt.Sprintf("tzdata/%s", curr.Name()))
+			if err != nil {
+				return fmt.Errorf("loadAllZones: could not read embedded tzdata %s: %w", curr.Name(), err)
+			}
+
+			zones, err := loadZonesFromTarGz(f)
+			if err != nil {
+				return fmt.Errorf("loadAllZones: tzdata %s: %w", m[1], err)
+			}
+			zonedata[m[1]] = zones
+			logger.Debug("loaded tzdata", "version", m[1], "zones", len(zones))
+		}
+	}
+
+	return nil
+}
+
+func allContain(x map[string][]int64, v int64) bool {
+	for _, c := range x {
+		if !slices.Contains(c, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func Solve(ctx context.Context, r io.Reader) (solver.Answer, error) {
+	in, err := solver.ReadLines(r)
+	if err != nil {
+		return "", err
+	}
+
+	logger := solver.Logger(ctx)
+
+	zonedataOnce.Do(func() {
+		zonedataErr = loadAllZones(logger)
+	})
+	if zonedataErr != nil {
+		return "", zonedataErr
+	}
+
+	stationsMoments := map[string][]int64{}
+	var firstZone *string
+
+	for i, curr := range in {
+		parts, err := input.Fields(curr, "; ", 2)
+		if err != nil {
+			return "", solver.AtLine(puzzleNumber, i+1, curr, fmt.Errorf("expected a time and a zone: %w", err))
+		}
+
+		t := parts[0]
+		zone := parts[1]
+
+		if firstZone == nil {
+			firstZone = &zone
+		}
+
+		for k, v := range zonedata {
+			l, err := time.LoadLocationFromTZData(zone, v[zone])
+			if err != nil {
+				logger.Warn("skipping zone version", "line", i+1, "zone", zone, "version", k, "err", err)
+				continue
+			}
+
+			moment, err := time.ParseInLocation(TimeFormatString, t, l)
+			if err != nil {
+				return "", solver.AtLine(puzzleNumber, i+1, curr, solver.AtColumn(1, fmt.Errorf("could not parse time: %w", err)))
+			}
+
+			utcTime := moment.UTC().Unix()
+			m := stationsMoments[zone]
+			m = append(m, utcTime)
+			stationsMoments[zone] = m
+		}
+	}
+
+	if firstZone == nil {
+		return "", solver.Errorf(puzzleNumber, "input does not mention any zones")
+	}
+
+	toCheck := stationsMoments[*firstZone]
+	for _, curr := range toCheck {
+		if allContain(stationsMoments, curr) {
+			winner := time.Unix(curr, 0).UTC()
+			return solver.Answer(winner.Format("2006-01-02T15:04:05-07:00")), nil
+		}
+	}
+
+	return "", fmt.Errorf("oldtz: Solve: no moment common to every station found")
+}
+
+func init() {
+	solver.Register(Puzzle)
+}
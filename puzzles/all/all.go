@@ -0,0 +1,27 @@
+// Package all registers every puzzle solver with the solver package. Import it for its side effects:
+//
+//	import _ "github.com/lthummus/i18n-puzzles/puzzles/all"
+package all
+
+import (
+	_ "github.com/lthummus/i18n-puzzles/puzzles/01-lengths/lengths"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/02-times/times"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/03-passwords/passwords"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/04-travel/travel"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/05-poop/poop"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/06-mojibake/mojibake"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/07-gmt/gmt"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/08-passwords-redux/passwordsredux"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/09-diaries/diaries"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/10-bcrypt/bcryptauth"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/11-odysseus/odysseus"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/12-phone-book/phonebook"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/13-gulliver/gulliver"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/14-japanese-area/japanesearea"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/15-support-times/supporttimes"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/16-pipes/pipes"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/17-treasure/treasure"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/18-math/bidimath"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/19-old-tz/oldtz"
+	_ "github.com/lthummus/i18n-puzzles/puzzles/20-future/future"
+)
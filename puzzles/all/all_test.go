@@ -0,0 +1,65 @@
+package all
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input"
+	"github.com/lthummus/i18n-puzzles/solver"
+)
+
+// fixtures are small made up inputs for every puzzle, laid out like the cache (NN-test.txt, with their answers in
+// answers.json). They're checked in so the benchmarks don't depend on anyone's real input, which we aren't allowed to
+// share
+var fixtures = &input.FileSource{Directory: "testdata"}
+
+func fixture(tb testing.TB, num int) []byte {
+	tb.Helper()
+
+	data, err := fixtures.Input(context.Background(), num, input.TestInput)
+	require.NoError(tb, err, "no fixture for puzzle %d in testdata", num)
+
+	return data
+}
+
+func Test_Fixtures(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join(fixtures.Directory, input.AnswersFileName))
+	require.NoError(t, err)
+
+	var expected input.ExpectedAnswers
+	require.NoError(t, json.Unmarshal(b, &expected))
+
+	for _, p := range solver.All() {
+		t.Run(fmt.Sprintf("%02d-%s", p.Number, p.Name), func(t *testing.T) {
+			want, ok := expected[p.Number][input.TestInput]
+			require.True(t, ok, "no answer for puzzle %d in testdata/%s", p.Number, input.AnswersFileName)
+
+			ans, err := p.Solver.Solve(context.Background(), bytes.NewReader(fixture(t, p.Number)))
+			require.NoError(t, err)
+			assert.Equal(t, want, string(ans))
+		})
+	}
+}
+
+func Benchmark_Puzzles(b *testing.B) {
+	for _, p := range solver.All() {
+		b.Run(fmt.Sprintf("%02d-%s", p.Number, p.Name), func(b *testing.B) {
+			in := fixture(b, p.Number)
+
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := p.Solver.Solve(context.Background(), bytes.NewReader(in)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Package sms works out how text is sent as SMS: which encoding it needs (GSM-7, optionally with a national language
+// shift table, or UCS-2), how many septets or UTF-16 code units that takes, and how a long message is split in to
+// concatenated segments
+package sms
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrNotEncodable means some text can't be written in GSM-7 with the tables asked for
+var ErrNotEncodable = errors.New("sms: not encodable in GSM-7")
+
+// EncodeError says which character couldn't be encoded
+type EncodeError struct {
+	Rune rune
+
+	// Offset is the byte offset of Rune in the text
+	Offset int
+}
+
+func (e *EncodeError) Error() string {
+	return fmt.Sprintf("sms: %U (%q) at byte %d is not in the GSM-7 tables", e.Rune, e.Rune, e.Offset)
+}
+
+func (e *EncodeError) Unwrap() error {
+	return ErrNotEncodable
+}
+
+// Tables is a pair of GSM-7 tables: the locking shift table used for every septet, and the single shift table used for
+// a septet after an escape. The zero value is the plain GSM 03.38 default alphabet and its extension table. Spanish
+// only has a single shift table
+type Tables struct {
+	Locking Language
+	Single  Language
+}
+
+func (t Tables) String() string {
+	return fmt.Sprintf("locking=%s single=%s", t.Locking, t.Single)
+}
+
+// Valid is whether both tables exist
+func (t Tables) Valid() bool {
+	_, lockingOK := lockingTables[t.Locking]
+	_, singleOK := singleTables[t.Single]
+	return lockingOK && singleOK
+}
+
+// headerOctets is how many octets of user data header it takes to tell the phone which national tables are in use
+func (t Tables) headerOctets() int {
+	ret := 0
+	if t.Locking != Default {
+		ret += 3
+	}
+	if t.Single != Default {
+		ret += 3
+	}
+	return ret
+}
+
+// septets is how many septets r takes with t: 1 in the locking table, 2 in the single shift table (counting the
+// escape), or 0 if it can't be encoded at all
+func (t Tables) septets(r rune) int {
+	if _, ok := lockingReverse[t.Locking][r]; ok {
+		return 1
+	}
+	if _, ok := singleReverse[t.Single][r]; ok {
+		return 2
+	}
+	return 0
+}
+
+// EncodeGSM7 turns text in to septets (one per byte, unpacked) using t. Characters from the single shift table take
+// two septets: an escape and then the character
+func EncodeGSM7(text string, t Tables) ([]byte, error) {
+	if !t.Valid() {
+		return nil, fmt.Errorf("sms: EncodeGSM7: no such tables: %s", t)
+	}
+
+	ret := make([]byte, 0, len(text))
+	for i, r := range text {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(text[i:]); size == 1 {
+				return nil, fmt.Errorf("sms: EncodeGSM7: invalid UTF-8 at byte %d: %w", i, ErrNotEncodable)
+			}
+		}
+
+		if septet, ok := lockingReverse[t.Locking][r]; ok {
+			ret = append(ret, septet)
+		} else if septet, ok := singleReverse[t.Single][r]; ok {
+			ret = append(ret, escape, septet)
+		} else {
+			return nil, &EncodeError{Rune: r, Offset: i}
+		}
+	}
+
+	return ret, nil
+}
+
+// DecodeGSM7 turns septets (one per byte, unpacked) back in to text using t. As TS 23.038 asks, an escape followed by
+// something that isn't in the single shift table is shown as the locking table's character
+func DecodeGSM7(septets []byte, t Tables) (string, error) {
+	if !t.Valid() {
+		return "", fmt.Errorf("sms: DecodeGSM7: no such tables: %s", t)
+	}
+
+	locking := lockingTables[t.Locking]
+	single := singleTables[t.Single]
+
+	var sb strings.Builder
+	for i := 0; i < len(septets); i++ {
+		septet := septets[i]
+		if septet > 0x7F {
+			return "", fmt.Errorf("sms: DecodeGSM7: 0x%02X at %d is not a septet", septet, i)
+		}
+
+		if septet != escape {
+			sb.WriteRune(locking[septet])
+			continue
+		}
+
+		i++
+		if i == len(septets) {
+			return "", fmt.Errorf("sms: DecodeGSM7: text ends with an escape")
+		}
+		septet = septets[i]
+		if septet > 0x7F {
+			return "", fmt.Errorf("sms: DecodeGSM7: 0x%02X at %d is not a septet", septet, i)
+		}
+
+		if r, ok := single[septet]; ok {
+			sb.WriteRune(r)
+		} else if septet == escape {
+			// reserved for a further extension table, which is shown as a space until there is one
+			sb.WriteRune(' ')
+		} else {
+			sb.WriteRune(locking[septet])
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// PackSeptets packs septets in to octets the way they're sent over the air: 8 septets in every 7 octets, least
+// significant bits first
+func PackSeptets(septets []byte) []byte {
+	ret := make([]byte, (len(septets)*7+7)/8)
+
+	for i, septet := range septets {
+		bit := i * 7
+		pos := bit / 8
+		shift := bit % 8
+
+		ret[pos] |= (septet & 0x7F) << shift
+		if shift > 1 {
+			ret[pos+1] |= (septet & 0x7F) >> (8 - shift)
+		}
+	}
+
+	return ret
+}
+
+// UnpackSeptets is the opposite of PackSeptets. The number of septets is needed since 7 septets and 8 septets both pack
+// in to 7 octets
+func UnpackSeptets(packed []byte, count int) ([]byte, error) {
+	if count < 0 || (count*7+7)/8 > len(packed) {
+		return nil, fmt.Errorf("sms: UnpackSeptets: %d octets can't hold %d septets", len(packed), count)
+	}
+
+	ret := make([]byte, count)
+	for i := range ret {
+		bit := i * 7
+		pos := bit / 8
+		shift := bit % 8
+
+		septet := packed[pos] >> shift
+		if shift > 1 {
+			septet |= packed[pos+1] << (8 - shift)
+		}
+		ret[i] = septet & 0x7F
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,175 @@
+package sms
+
+import (
+	"unicode/utf16"
+)
+
+// Encoding is how a message's text is sent
+type Encoding int
+
+const (
+	GSM7 Encoding = iota
+	UCS2
+)
+
+func (e Encoding) String() string {
+	if e == UCS2 {
+		return "UCS-2"
+	}
+	return "GSM-7"
+}
+
+const (
+	// userDataOctets is how much room there is for text (and the user data header) in one SMS
+	userDataOctets = 140
+
+	// concatOctets is the user data header information element that says a message is one of several, with an 8-bit
+	// reference number
+	concatOctets = 5
+)
+
+// Segment is one SMS of a message
+type Segment struct {
+	// Start and End are byte offsets in to the message's text
+	Start int
+	End   int
+
+	// Units is how many septets (for GSM-7) or UTF-16 code units (for UCS-2) the segment's text takes
+	Units int
+}
+
+// Message is how some text would be sent
+type Message struct {
+	Encoding Encoding
+
+	// Tables are the GSM-7 tables used. It is only meaningful if Encoding is GSM7
+	Tables Tables
+
+	// Units is how many septets (for GSM-7) or UTF-16 code units (for UCS-2) the whole text takes
+	Units int
+
+	// Capacity is how many units fit in each segment. It is smaller for concatenated messages and when national
+	// language tables are used, since the user data header that says so takes up room too
+	Capacity int
+
+	Segments []Segment
+}
+
+// capacity is how many units fit in one SMS alongside user data header information elements taking up headerOctets.
+// For GSM-7 the header is padded out to a septet boundary
+func capacity(enc Encoding, headerOctets int) int {
+	if headerOctets > 0 {
+		// the header's length
+		headerOctets++
+	}
+
+	if enc == UCS2 {
+		return (userDataOctets - headerOctets) / 2
+	}
+	return (userDataOctets*8 - headerOctets*8) / 7
+}
+
+// Split works out how text would be sent by a carrier. GSM-7 is used if it can be, with whichever of the default
+// alphabet and the national language tables allowed needs the fewest segments. UCS-2 is only used if none of them can
+// encode the text
+func Split(text string, languages ...Language) Message {
+	best, ok := segmentGSM7(text, Tables{})
+
+	for _, lang := range languages {
+		for _, t := range []Tables{{Single: lang}, {Locking: lang}, {Locking: lang, Single: lang}} {
+			if !t.Valid() || t == (Tables{}) {
+				continue
+			}
+
+			m, fits := segmentGSM7(text, t)
+			if fits && (!ok || m.better(best)) {
+				best, ok = m, true
+			}
+		}
+	}
+
+	if ok {
+		return best
+	}
+
+	return segmentUCS2(text)
+}
+
+// better is whether m should be sent instead of other: it takes fewer segments, or the same number but fewer units. The
+// default alphabet works on every phone, so it is only beaten on segments
+func (m Message) better(other Message) bool {
+	if len(m.Segments) != len(other.Segments) {
+		return len(m.Segments) < len(other.Segments)
+	}
+	return other.Tables != (Tables{}) && m.Units < other.Units
+}
+
+// segmentGSM7 splits text in to GSM-7 segments using t, returning false if it has characters t can't encode
+func segmentGSM7(text string, t Tables) (Message, bool) {
+	m := Message{Encoding: GSM7, Tables: t}
+
+	costs := make([]int, 0, len(text))
+	for _, r := range text {
+		cost := t.septets(r)
+		if cost == 0 {
+			return Message{}, false
+		}
+		costs = append(costs, cost)
+		m.Units += cost
+	}
+
+	m.Capacity = capacity(GSM7, t.headerOctets())
+	if m.Units > m.Capacity {
+		m.Capacity = capacity(GSM7, t.headerOctets()+concatOctets)
+	}
+
+	m.Segments = cut(text, costs, m.Capacity)
+	return m, true
+}
+
+func segmentUCS2(text string) Message {
+	m := Message{Encoding: UCS2}
+
+	costs := make([]int, 0, len(text))
+	for _, r := range text {
+		// characters outside the BMP take a surrogate pair, which mustn't be split across segments
+		cost := utf16.RuneLen(r)
+		if cost < 0 {
+			// invalid UTF-8 is sent as U+FFFD
+			cost = 1
+		}
+		costs = append(costs, cost)
+		m.Units += cost
+	}
+
+	m.Capacity = capacity(UCS2, 0)
+	if m.Units > m.Capacity {
+		m.Capacity = capacity(UCS2, concatOctets)
+	}
+
+	m.Segments = cut(text, costs, m.Capacity)
+	return m
+}
+
+// cut cuts text in to segments of at most capacity units, given what each of its characters costs. Characters are
+// never split, so escapes stay with what they escape and surrogate pairs stay together
+func cut(text string, costs []int, capacity int) []Segment {
+	var ret []Segment
+	curr := Segment{}
+
+	i := 0
+	for offset := range text {
+		cost := costs[i]
+		i++
+
+		if curr.Units+cost > capacity {
+			curr.End = offset
+			ret = append(ret, curr)
+			curr = Segment{Start: offset}
+		}
+		curr.Units += cost
+	}
+
+	curr.End = len(text)
+	return append(ret, curr)
+}
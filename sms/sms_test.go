@@ -0,0 +1,202 @@
+package sms
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncodeDecodeGSM7(t *testing.T) {
+	t.Run("default alphabet", func(t *testing.T) {
+		septets, err := EncodeGSM7("Hi @ 5€ [ok]", Tables{})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x48, 0x69, 0x20, 0x00, 0x20, 0x35, 0x1B, 0x65, 0x20, 0x1B, 0x3C, 0x6F, 0x6B, 0x1B, 0x3E}, septets)
+
+		s, err := DecodeGSM7(septets, Tables{})
+		require.NoError(t, err)
+		assert.Equal(t, "Hi @ 5€ [ok]", s)
+	})
+
+	t.Run("not encodable", func(t *testing.T) {
+		_, err := EncodeGSM7("naïve ç", Tables{})
+		assert.ErrorIs(t, err, ErrNotEncodable)
+		var ee *EncodeError
+		require.ErrorAs(t, err, &ee)
+		assert.Equal(t, 'ï', ee.Rune)
+		assert.Equal(t, 2, ee.Offset)
+
+		_, err = EncodeGSM7("bad \xff", Tables{})
+		assert.ErrorIs(t, err, ErrNotEncodable)
+
+		_, err = EncodeGSM7("x", Tables{Locking: Spanish})
+		assert.ErrorContains(t, err, "no such tables")
+	})
+
+	t.Run("national tables", func(t *testing.T) {
+		for _, tc := range []struct {
+			tables  Tables
+			text    string
+			septets int
+		}{
+			{tables: Tables{Locking: Turkish}, text: "Günaydın, Şule! Çay içelim mi? 5€", septets: 33},
+			{tables: Tables{Single: Turkish}, text: "ığş", septets: 6},
+			{tables: Tables{Single: Spanish}, text: "¿Cómo estás? Sí", septets: 18},
+			{tables: Tables{Locking: Portuguese, Single: Portuguese}, text: "Olá, não há ações às 5ª {ok}", septets: 30},
+		} {
+			septets, err := EncodeGSM7(tc.text, tc.tables)
+			require.NoError(t, err, tc.tables)
+			assert.Len(t, septets, tc.septets, tc.tables)
+
+			s, err := DecodeGSM7(septets, tc.tables)
+			require.NoError(t, err)
+			assert.Equal(t, tc.text, s)
+		}
+	})
+
+	t.Run("every character round trips", func(t *testing.T) {
+		for lang := range lockingTables {
+			for single := range singleTables {
+				tables := Tables{Locking: lang, Single: single}
+				var chars []rune
+				for _, r := range lockingTables[lang] {
+					if r != noChar {
+						chars = append(chars, r)
+					}
+				}
+				for _, r := range singleTables[single] {
+					chars = append(chars, r)
+				}
+
+				septets, err := EncodeGSM7(string(chars), tables)
+				require.NoError(t, err, tables)
+				s, err := DecodeGSM7(septets, tables)
+				require.NoError(t, err, tables)
+				assert.Equal(t, string(chars), s, tables)
+			}
+		}
+	})
+
+	t.Run("bad septets", func(t *testing.T) {
+		_, err := DecodeGSM7([]byte{0x41, 0x1B}, Tables{})
+		assert.ErrorContains(t, err, "ends with an escape")
+
+		_, err = DecodeGSM7([]byte{0x80}, Tables{})
+		assert.Error(t, err)
+
+		// unknown escapes fall back to the locking table
+		s, err := DecodeGSM7([]byte{0x1B, 0x41, 0x1B, 0x1B}, Tables{})
+		require.NoError(t, err)
+		assert.Equal(t, "A ", s)
+	})
+}
+
+func Test_PackSeptets(t *testing.T) {
+	septets, err := EncodeGSM7("hellohello", Tables{})
+	require.NoError(t, err)
+
+	packed := PackSeptets(septets)
+	assert.Equal(t, []byte{0xE8, 0x32, 0x9B, 0xFD, 0x46, 0x97, 0xD9, 0xEC, 0x37}, packed)
+
+	unpacked, err := UnpackSeptets(packed, len(septets))
+	require.NoError(t, err)
+	assert.Equal(t, septets, unpacked)
+
+	for n := range 20 {
+		septets := make([]byte, n)
+		for i := range septets {
+			septets[i] = byte(0x7F - i)
+		}
+		packed := PackSeptets(septets)
+		assert.Len(t, packed, (n*7+7)/8)
+
+		unpacked, err := UnpackSeptets(packed, n)
+		require.NoError(t, err)
+		assert.Equal(t, septets, unpacked, n)
+	}
+
+	_, err = UnpackSeptets([]byte{0x00}, 3)
+	assert.Error(t, err)
+}
+
+func Test_Split(t *testing.T) {
+	t.Run("short GSM-7", func(t *testing.T) {
+		m := Split("hello")
+		assert.Equal(t, GSM7, m.Encoding)
+		assert.Equal(t, 5, m.Units)
+		assert.Equal(t, 160, m.Capacity)
+		assert.Equal(t, []Segment{{Start: 0, End: 5, Units: 5}}, m.Segments)
+
+		m = Split("")
+		assert.Len(t, m.Segments, 1)
+	})
+
+	t.Run("long GSM-7", func(t *testing.T) {
+		assert.Len(t, Split(strings.Repeat("a", 160)).Segments, 1)
+
+		m := Split(strings.Repeat("a", 161))
+		assert.Equal(t, 153, m.Capacity)
+		assert.Equal(t, []Segment{{Start: 0, End: 153, Units: 153}, {Start: 153, End: 161, Units: 8}}, m.Segments)
+	})
+
+	t.Run("escapes cost two and are never split", func(t *testing.T) {
+		m := Split(strings.Repeat("€", 80))
+		assert.Equal(t, 160, m.Units)
+		assert.Len(t, m.Segments, 1)
+
+		m = Split(strings.Repeat("€", 81))
+		require.Len(t, m.Segments, 2)
+		assert.Equal(t, 152, m.Segments[0].Units)
+		assert.Equal(t, 76*len("€"), m.Segments[0].End)
+		assert.Equal(t, 10, m.Segments[1].Units)
+	})
+
+	t.Run("UCS-2", func(t *testing.T) {
+		m := Split("Привет")
+		assert.Equal(t, UCS2, m.Encoding)
+		assert.Equal(t, 6, m.Units)
+		assert.Equal(t, 70, m.Capacity)
+
+		m = Split(strings.Repeat("ж", 71))
+		assert.Equal(t, 67, m.Capacity)
+		assert.Len(t, m.Segments, 2)
+
+		// surrogate pairs stay together
+		m = Split(strings.Repeat("💩", 35))
+		assert.Equal(t, 70, m.Units)
+		assert.Len(t, m.Segments, 1)
+
+		m = Split(strings.Repeat("💩", 36))
+		require.Len(t, m.Segments, 2)
+		assert.Equal(t, 66, m.Segments[0].Units)
+		assert.Equal(t, 6, m.Segments[1].Units)
+	})
+
+	t.Run("national languages", func(t *testing.T) {
+		m := Split("çay")
+		assert.Equal(t, UCS2, m.Encoding, "ç isn't in the default alphabet")
+
+		m = Split("çay", Spanish)
+		assert.Equal(t, GSM7, m.Encoding)
+		assert.Equal(t, Tables{Single: Spanish}, m.Tables)
+		assert.Equal(t, 4, m.Units)
+		assert.Equal(t, 155, m.Capacity, "the shift table header takes room")
+
+		m = Split("ğüşıöç", Turkish)
+		assert.Equal(t, Tables{Locking: Turkish}, m.Tables, "fewest septets")
+		assert.Equal(t, 6, m.Units)
+
+		m = Split("plain text", Turkish, Portuguese)
+		assert.Equal(t, Tables{}, m.Tables, "the default alphabet is used when it's as good")
+
+		m = Split(strings.Repeat("ş", 100), Turkish)
+		assert.Equal(t, Tables{Locking: Turkish}, m.Tables)
+		assert.Equal(t, 155, m.Capacity)
+		assert.Len(t, m.Segments, 1)
+
+		m = Split(strings.Repeat("ş", 200), Turkish)
+		assert.Equal(t, 149, m.Capacity)
+		assert.Len(t, m.Segments, 2)
+	})
+}
@@ -0,0 +1,63 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// BenchResult is how a solver did over a number of timed runs. The per-op figures are averages, the same as `go test
+// -bench` reports
+type BenchResult struct {
+	Runs        int   `json:"runs"`
+	NsPerOp     int64 `json:"ns_per_op"`
+	AllocsPerOp int64 `json:"allocs_per_op"`
+	BytesPerOp  int64 `json:"bytes_per_op"`
+
+	Fastest time.Duration `json:"fastest_ns"`
+	Slowest time.Duration `json:"slowest_ns"`
+}
+
+// Bench solves in runs times and measures how long it took and how much it allocated. There is one untimed run first
+// so one-time setup (like loading zone data) doesn't count, and so a solver that fails is caught before any timing
+func (p Puzzle) Bench(ctx context.Context, in []byte, runs int) (BenchResult, error) {
+	if runs < 1 {
+		return BenchResult{}, fmt.Errorf("Bench: need at least one run, got %d", runs)
+	}
+
+	if _, err := p.Solver.Solve(ctx, bytes.NewReader(in)); err != nil {
+		return BenchResult{}, err
+	}
+
+	res := BenchResult{Runs: runs}
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	var total time.Duration
+	for i := range runs {
+		start := time.Now()
+		_, err := p.Solver.Solve(ctx, bytes.NewReader(in))
+		dur := time.Since(start)
+		if err != nil {
+			return BenchResult{}, err
+		}
+
+		total += dur
+		if i == 0 || dur < res.Fastest {
+			res.Fastest = dur
+		}
+		res.Slowest = max(res.Slowest, dur)
+	}
+
+	runtime.ReadMemStats(&after)
+
+	res.NsPerOp = total.Nanoseconds() / int64(runs)
+	res.AllocsPerOp = int64(after.Mallocs-before.Mallocs) / int64(runs)
+	res.BytesPerOp = int64(after.TotalAlloc-before.TotalAlloc) / int64(runs)
+
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package solver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InputError describes a problem with a specific part of a puzzle's input. Solvers return these instead of panicking,
+// so a malformed line in the input is reported instead of crashing whatever is running the solver
+type InputError struct {
+	Puzzle int
+
+	// Line is the 1-based line number of the problem in the whole input, or 0 if it isn't tied to one line
+	Line int
+
+	// Column is the 1-based position of the problem within the line (in runes, unless the line is binary data), or 0
+	// if unknown
+	Column int
+
+	// Text is the offending line, or the part of it that caused the problem
+	Text string
+
+	Err error
+}
+
+func (e *InputError) Error() string {
+	var sb strings.Builder
+
+	if e.Puzzle > 0 {
+		fmt.Fprintf(&sb, "puzzle %d: ", e.Puzzle)
+	}
+	if e.Line > 0 {
+		fmt.Fprintf(&sb, "line %d: ", e.Line)
+	}
+	if e.Column > 0 {
+		fmt.Fprintf(&sb, "column %d: ", e.Column)
+	}
+	if e.Text != "" {
+		fmt.Fprintf(&sb, "%q: ", e.Text)
+	}
+	sb.WriteString(e.Err.Error())
+
+	return sb.String()
+}
+
+func (e *InputError) Unwrap() error {
+	return e.Err
+}
+
+// AtColumn marks an error as happening at a particular column. Helpers that work on a single line use this, and leave
+// it to their caller to say which line (and puzzle) it was with AtLine
+func AtColumn(column int, err error) error {
+	return &InputError{
+		Column: column,
+		Err:    err,
+	}
+}
+
+// AtLine attaches the puzzle number, line number and offending text to an error. If err is already an InputError (say,
+// from AtColumn), that one is filled in rather than wrapped again
+func AtLine(puzzle int, line int, text string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if ie, ok := err.(*InputError); ok {
+		filled := *ie
+		if filled.Puzzle == 0 {
+			filled.Puzzle = puzzle
+		}
+		if filled.Line == 0 {
+			filled.Line = line
+		}
+		if filled.Text == "" {
+			filled.Text = text
+		}
+		return &filled
+	}
+
+	return &InputError{
+		Puzzle: puzzle,
+		Line:   line,
+		Text:   text,
+		Err:    err,
+	}
+}
+
+// Errorf builds an InputError for a problem with the input as a whole rather than any one line of it
+func Errorf(puzzle int, format string, args ...any) error {
+	return &InputError{
+		Puzzle: puzzle,
+		Err:    fmt.Errorf(format, args...),
+	}
+}
@@ -0,0 +1,42 @@
+package solver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_InputError(t *testing.T) {
+	cause := errors.New("bad digit")
+
+	t.Run("line only", func(t *testing.T) {
+		err := AtLine(14, 3, "三x", cause)
+		assert.Equal(t, `puzzle 14: line 3: "三x": bad digit`, err.Error())
+		assert.ErrorIs(t, err, cause)
+	})
+
+	t.Run("column filled in by caller", func(t *testing.T) {
+		err := AtLine(14, 3, "三x", AtColumn(2, cause))
+		assert.Equal(t, `puzzle 14: line 3: column 2: "三x": bad digit`, err.Error())
+
+		var ie *InputError
+		require.ErrorAs(t, err, &ie)
+		assert.Equal(t, 14, ie.Puzzle)
+		assert.Equal(t, 3, ie.Line)
+		assert.Equal(t, 2, ie.Column)
+		assert.Equal(t, "三x", ie.Text)
+		assert.ErrorIs(t, err, cause)
+	})
+
+	t.Run("whole input", func(t *testing.T) {
+		err := Errorf(7, "no zones: %w", cause)
+		assert.Equal(t, "puzzle 7: no zones: bad digit", err.Error())
+		assert.ErrorIs(t, err, cause)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.NoError(t, AtLine(1, 1, "", nil))
+	})
+}
@@ -0,0 +1,44 @@
+package solver
+
+import (
+	"context"
+	"io"
+	"log/slog"
+)
+
+type loggerKey struct{}
+
+// WithLogger returns a context that makes Logger (and so every solver given the context) use l
+func WithLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// Logger is where solvers send diagnostic output, such as progress and intermediate results. Unless WithLogger was
+// used on ctx, everything is thrown away
+func Logger(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok && l != nil {
+		return l
+	}
+
+	return slog.New(slog.DiscardHandler)
+}
+
+// NewConsoleLogger is the logger the command line tools use: informational messages (like downloads) normally, and
+// everything including solver traces if verbose is set. Times are left out, since they just get in the way on a
+// terminal
+func NewConsoleLogger(w io.Writer, verbose bool) *slog.Logger {
+	level := slog.LevelInfo
+	if verbose {
+		level = slog.LevelDebug
+	}
+
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+}
@@ -0,0 +1,34 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Logger(t *testing.T) {
+	t.Run("quiet by default", func(t *testing.T) {
+		l := Logger(context.Background())
+		assert.False(t, l.Enabled(context.Background(), slog.LevelError))
+	})
+
+	t.Run("from context", func(t *testing.T) {
+		var buf bytes.Buffer
+		ctx := WithLogger(context.Background(), NewConsoleLogger(&buf, true))
+
+		Logger(ctx).Debug("checked attempts", "count", 3)
+		assert.Equal(t, "level=DEBUG msg=\"checked attempts\" count=3\n", buf.String())
+	})
+
+	t.Run("console logger hides debug unless verbose", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := NewConsoleLogger(&buf, false)
+
+		l.Debug("trace")
+		l.Info("downloading input", "puzzle", 1)
+		assert.Equal(t, "level=INFO msg=\"downloading input\" puzzle=1\n", buf.String())
+	})
+}
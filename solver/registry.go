@@ -0,0 +1,60 @@
+package solver
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+type Puzzle struct {
+	Number int
+	Name   string
+	Solver Solver
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[int]Puzzle{}
+)
+
+// Register makes a puzzle available to the i18n command. It is meant to be called from the init function of each
+// puzzle's package and panics if two puzzles claim the same number
+func Register(p Puzzle) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if p.Solver == nil {
+		panic(fmt.Sprintf("solver: Register: puzzle %d has no solver", p.Number))
+	}
+
+	if existing, ok := registry[p.Number]; ok {
+		panic(fmt.Sprintf("solver: Register: puzzle %d registered twice (%s and %s)", p.Number, existing.Name, p.Name))
+	}
+
+	registry[p.Number] = p
+}
+
+func Lookup(num int) (Puzzle, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	p, ok := registry[num]
+	return p, ok
+}
+
+// All returns every registered puzzle, ordered by puzzle number
+func All() []Puzzle {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	ret := make([]Puzzle, 0, len(registry))
+	for _, curr := range registry {
+		ret = append(ret, curr)
+	}
+
+	slices.SortFunc(ret, func(a, b Puzzle) int {
+		return a.Number - b.Number
+	})
+
+	return ret
+}
@@ -0,0 +1,185 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/lthummus/i18n-puzzles/input"
+)
+
+// Diagnostic is one message a solver logged (see Logger) while it was working
+type Diagnostic struct {
+	Level   slog.Level     `json:"level"`
+	Message string         `json:"msg"`
+	Attrs   map[string]any `json:"attrs,omitempty"`
+}
+
+// Timings is how long each part of solving a puzzle took
+type Timings struct {
+	Input time.Duration `json:"input_ns"`
+	Solve time.Duration `json:"solve_ns"`
+}
+
+// Result is everything that came out of solving a puzzle, in a form that can be turned in to JSON
+type Result struct {
+	Puzzle int        `json:"puzzle"`
+	Name   string     `json:"name"`
+	Kind   input.Kind `json:"kind"`
+
+	Answer Answer `json:"answer"`
+
+	// Error is set if the solver (or fetching its input) failed, in which case Answer is empty. Err is the same thing
+	// as an error
+	Error string `json:"error,omitempty"`
+	Err   error  `json:"-"`
+
+	Diagnostics []Diagnostic `json:"diagnostics,omitempty"`
+	Timings     Timings      `json:"timings"`
+}
+
+// Solve fetches the input for a puzzle using c and solves it, the same as Run, but also collects everything the solver
+// logged and how long it all took. Anything logged still goes to the logger on ctx as well
+func (p Puzzle) Solve(ctx context.Context, c *input.Client, kind input.Kind) Result {
+	res := Result{
+		Puzzle: p.Number,
+		Name:   p.Name,
+		Kind:   kind,
+	}
+
+	start := time.Now()
+	in, err := c.GetInputBytes(ctx, p.Number, kind)
+	res.Timings.Input = time.Since(start)
+	if err != nil {
+		res.Err = err
+		res.Error = err.Error()
+		return res
+	}
+
+	rec := &recorder{next: Logger(ctx).Handler(), shared: &recorded{}}
+	ctx = WithLogger(ctx, slog.New(rec))
+
+	start = time.Now()
+	ans, err := p.Solver.Solve(ctx, bytes.NewReader(in))
+	res.Timings.Solve = time.Since(start)
+
+	res.Diagnostics = rec.diagnostics()
+	if err != nil {
+		res.Err = err
+		res.Error = err.Error()
+		return res
+	}
+	res.Answer = ans
+
+	return res
+}
+
+// recorder is a slog.Handler that keeps every record as a Diagnostic, whatever its level, and passes it on to next
+// (which makes its own decision about the level)
+type recorder struct {
+	next   slog.Handler
+	shared *recorded
+
+	// attrs and prefix come from WithAttrs and WithGroup
+	attrs  []slog.Attr
+	prefix string
+}
+
+// recorded is shared between a recorder and everything derived from it with WithAttrs and WithGroup. Solvers are free
+// to log from more than one goroutine
+type recorded struct {
+	mu   sync.Mutex
+	list []Diagnostic
+}
+
+func (r *recorder) diagnostics() []Diagnostic {
+	r.shared.mu.Lock()
+	defer r.shared.mu.Unlock()
+
+	return r.shared.list
+}
+
+func (r *recorder) Enabled(_ context.Context, _ slog.Level) bool {
+	return true
+}
+
+func (r *recorder) Handle(ctx context.Context, record slog.Record) error {
+	d := Diagnostic{
+		Level:   record.Level,
+		Message: record.Message,
+	}
+
+	add := func(prefix string, a slog.Attr) {
+		if d.Attrs == nil {
+			d.Attrs = map[string]any{}
+		}
+		addAttr(d.Attrs, prefix, a)
+	}
+	for _, a := range r.attrs {
+		add("", a)
+	}
+	record.Attrs(func(a slog.Attr) bool {
+		add(r.prefix, a)
+		return true
+	})
+
+	r.shared.mu.Lock()
+	r.shared.list = append(r.shared.list, d)
+	r.shared.mu.Unlock()
+
+	if r.next.Enabled(ctx, record.Level) {
+		return r.next.Handle(ctx, record)
+	}
+
+	return nil
+}
+
+func (r *recorder) WithAttrs(attrs []slog.Attr) slog.Handler {
+	ret := *r
+	ret.next = r.next.WithAttrs(attrs)
+	ret.attrs = append([]slog.Attr{}, r.attrs...)
+	for _, a := range attrs {
+		ret.attrs = append(ret.attrs, slog.Attr{Key: r.prefix + a.Key, Value: a.Value})
+	}
+
+	return &ret
+}
+
+func (r *recorder) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return r
+	}
+
+	ret := *r
+	ret.next = r.next.WithGroup(name)
+	ret.prefix = r.prefix + name + "."
+
+	return &ret
+}
+
+// addAttr flattens a (possibly grouped) attribute in to m, using dotted keys for groups. Values that JSON can't show
+// sensibly, like errors and durations, are turned in to strings
+func addAttr(m map[string]any, prefix string, a slog.Attr) {
+	v := a.Value.Resolve()
+	if a.Key == "" && v.Kind() != slog.KindGroup {
+		// slog ignores these too
+		return
+	}
+
+	switch v.Kind() {
+	case slog.KindGroup:
+		p := prefix
+		if a.Key != "" {
+			p += a.Key + "."
+		}
+		for _, curr := range v.Group() {
+			addAttr(m, p, curr)
+		}
+	case slog.KindInt64, slog.KindUint64, slog.KindFloat64, slog.KindBool:
+		m[prefix+a.Key] = v.Any()
+	default:
+		m[prefix+a.Key] = v.String()
+	}
+}
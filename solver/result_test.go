@@ -0,0 +1,107 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input"
+)
+
+func Test_PuzzleSolve(t *testing.T) {
+	ms := input.NewMemorySource()
+	ms.Set(99, input.TestInput, []byte("a\nbb\nccc\n"))
+	ms.Set(99, input.RealInput, []byte(""))
+
+	c, err := input.NewClient(input.WithCacheDir(t.TempDir()), input.WithSource(ms))
+	require.NoError(t, err)
+
+	p := Puzzle{
+		Number: 99,
+		Name:   "line-counter",
+		Solver: SolverFunc(func(ctx context.Context, r io.Reader) (Answer, error) {
+			logger := Logger(ctx)
+
+			lines, err := ReadLines(r)
+			if err != nil {
+				return "", err
+			}
+			if len(lines) == 0 {
+				logger.Warn("no lines")
+				return "", errors.New("empty input")
+			}
+
+			var wg sync.WaitGroup
+			for i, curr := range lines {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					logger.With("worker", i).Debug("line", "len", len(curr))
+				}()
+			}
+			wg.Wait()
+
+			logger.WithGroup("summary").Info("counted", "lines", len(lines), "took", time.Second, slog.Group("detail", "first", lines[0]))
+			return Answerf("%d", len(lines)), nil
+		}),
+	}
+
+	t.Run("answer and diagnostics", func(t *testing.T) {
+		var buf bytes.Buffer
+		ctx := WithLogger(context.Background(), NewConsoleLogger(&buf, false))
+
+		res := p.Solve(ctx, c, input.TestInput)
+		require.NoError(t, res.Err)
+		assert.Equal(t, Answer("3"), res.Answer)
+		assert.Equal(t, 99, res.Puzzle)
+		assert.Equal(t, input.TestInput, res.Kind)
+
+		require.Len(t, res.Diagnostics, 4)
+		assert.Equal(t, slog.LevelDebug, res.Diagnostics[0].Level)
+		assert.Equal(t, Diagnostic{
+			Level:   slog.LevelInfo,
+			Message: "counted",
+			Attrs: map[string]any{
+				"summary.lines":        int64(3),
+				"summary.took":         "1s",
+				"summary.detail.first": "a",
+			},
+		}, res.Diagnostics[3])
+
+		// only the info message gets through to the console logger, which isn't verbose
+		assert.Equal(t, "level=INFO msg=counted summary.lines=3 summary.took=1s summary.detail.first=a\n", buf.String())
+
+		b, err := json.Marshal(res)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `"answer":"3"`)
+		assert.Contains(t, string(b), `"kind":"test"`)
+		assert.Contains(t, string(b), `{"level":"INFO","msg":"counted","attrs":{"summary.detail.first":"a","summary.lines":3,"summary.took":"1s"}}`)
+		assert.NotContains(t, string(b), `"error"`)
+	})
+
+	t.Run("solver error", func(t *testing.T) {
+		res := p.Solve(context.Background(), c, input.RealInput)
+		assert.EqualError(t, res.Err, "empty input")
+		assert.Equal(t, "empty input", res.Error)
+		assert.Empty(t, res.Answer)
+		assert.Equal(t, []Diagnostic{{Level: slog.LevelWarn, Message: "no lines"}}, res.Diagnostics)
+	})
+
+	t.Run("no input", func(t *testing.T) {
+		c, err := input.NewClient(input.WithCacheDir(t.TempDir()), input.WithSource(input.NewMemorySource()))
+		require.NoError(t, err)
+
+		res := p.Solve(context.Background(), c, input.TestInput)
+		assert.ErrorIs(t, res.Err, input.ErrNoInput)
+		assert.Zero(t, res.Timings.Solve)
+	})
+}
@@ -0,0 +1,110 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/lthummus/i18n-puzzles/input"
+)
+
+// Answer is what a solver came up with, in the form the puzzle site expects it to be typed in
+type Answer string
+
+func Answerf(format string, args ...any) Answer {
+	return Answer(fmt.Sprintf(format, args...))
+}
+
+func (a Answer) String() string {
+	return string(a)
+}
+
+// Solver solves a puzzle given its input. Solvers don't know or care where the input came from
+type Solver interface {
+	Solve(ctx context.Context, r io.Reader) (Answer, error)
+}
+
+// SolverFunc lets an ordinary function be used as a Solver. Solvers are expected to return errors for bad input, but
+// as a last line of defence a panic is turned in to an error too, so one bad input can't take down whatever is calling
+// the solver
+type SolverFunc func(ctx context.Context, r io.Reader) (Answer, error)
+
+func (f SolverFunc) Solve(ctx context.Context, r io.Reader) (ans Answer, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			ans = ""
+			err = fmt.Errorf("solver: Solve: solver panicked: %v", rec)
+		}
+	}()
+
+	return f(ctx, r)
+}
+
+// ReadString reads the whole input as a string
+func ReadString(r io.Reader) (string, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("solver: ReadString: could not read input: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// ReadLines reads the whole input and splits it in to lines the same way input.GetInputLinesUTF8 does
+func ReadLines(r io.Reader) ([]string, error) {
+	s, err := ReadString(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return input.SplitLines(s), nil
+}
+
+// Run fetches the input for a puzzle using c and solves it
+func (p Puzzle) Run(ctx context.Context, c *input.Client, kind input.Kind) (Answer, error) {
+	in, err := c.GetInputBytes(ctx, p.Number, kind)
+	if err != nil {
+		return "", err
+	}
+
+	return p.Solver.Solve(ctx, bytes.NewReader(in))
+}
+
+// Main is used by the standalone main package of each puzzle. It solves the puzzle using the real input (or the test
+// input, given -test) and prints the answer. -verbose turns on the solver's diagnostic output
+func Main(p Puzzle) {
+	test := flag.Bool("test", false, "use the test input instead of the real input")
+	verbose := flag.Bool("verbose", false, "log what the downloader and solver are doing")
+	flag.Parse()
+
+	kind := input.RealInput
+	if *test {
+		kind = input.TestInput
+	}
+
+	logger := NewConsoleLogger(os.Stderr, *verbose)
+
+	c, err := input.NewClient(input.WithLogger(logger))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+
+	ans, err := p.Run(WithLogger(context.Background(), logger), c, kind)
+	var ie *InputError
+	if errors.As(err, &ie) && ie.Puzzle != 0 {
+		// already says which puzzle it came from
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "puzzle %d: %s\n", p.Number, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s\n", ans)
+}
@@ -0,0 +1,161 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lthummus/i18n-puzzles/input"
+)
+
+func Test_PuzzleRun(t *testing.T) {
+	ms := input.NewMemorySource()
+	ms.Set(99, input.TestInput, []byte("a\nbb\nccc\n"))
+
+	c, err := input.NewClient(input.WithCacheDir(t.TempDir()), input.WithSource(ms))
+	require.NoError(t, err)
+
+	p := Puzzle{
+		Number: 99,
+		Name:   "line-counter",
+		Solver: SolverFunc(func(_ context.Context, r io.Reader) (Answer, error) {
+			lines, err := ReadLines(r)
+			if err != nil {
+				return "", err
+			}
+			return Answerf("%d", len(lines)), nil
+		}),
+	}
+
+	ans, err := p.Run(context.Background(), c, input.TestInput)
+	assert.NoError(t, err)
+	assert.Equal(t, Answer("3"), ans)
+
+	_, err = p.Run(context.Background(), c, input.RealInput)
+	assert.ErrorIs(t, err, input.ErrNoInput)
+}
+
+func Test_ReadLines(t *testing.T) {
+	lines, err := ReadLines(strings.NewReader("one\ntwo"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"one", "two"}, lines)
+}
+
+func Test_SolverFuncRecovers(t *testing.T) {
+	s := SolverFunc(func(_ context.Context, _ io.Reader) (Answer, error) {
+		var m map[string]int
+		m["boom"] = 1
+		return "unreachable", nil
+	})
+
+	ans, err := s.Solve(context.Background(), strings.NewReader(""))
+	assert.Error(t, err)
+	assert.Equal(t, Answer(""), ans)
+}
+
+func Test_PuzzleVerify(t *testing.T) {
+	ms := input.NewMemorySource()
+	ms.Set(99, input.TestInput, []byte("a\nbb\n"))
+	ms.Set(99, input.RealInput, []byte("a\nbb\nccc\n"))
+
+	c, err := input.NewClient(input.WithCacheDir(t.TempDir()), input.WithSource(ms))
+	require.NoError(t, err)
+
+	p := Puzzle{
+		Number: 99,
+		Name:   "line-counter",
+		Solver: SolverFunc(func(_ context.Context, r io.Reader) (Answer, error) {
+			lines, err := ReadLines(r)
+			if err != nil {
+				return "", err
+			}
+			return Answerf("%d", len(lines)), nil
+		}),
+	}
+
+	res := p.Verify(context.Background(), c, input.TestInput)
+	assert.Equal(t, VerifyMissing, res.Status)
+	assert.Equal(t, Answer("2"), res.Got)
+	assert.False(t, res.Status.Regression())
+
+	require.NoError(t, c.SetExpectedAnswer(99, input.TestInput, "2"))
+	require.NoError(t, c.SetExpectedAnswer(99, input.RealInput, "4"))
+
+	res = p.Verify(context.Background(), c, input.TestInput)
+	assert.Equal(t, VerifyPass, res.Status)
+
+	res = p.Verify(context.Background(), c, input.RealInput)
+	assert.Equal(t, VerifyFail, res.Status)
+	assert.Equal(t, Answer("4"), res.Expected)
+	assert.Equal(t, Answer("3"), res.Got)
+	assert.True(t, res.Status.Regression())
+
+	res = (Puzzle{Number: 98, Solver: p.Solver}).Verify(context.Background(), c, input.RealInput)
+	assert.Equal(t, VerifyError, res.Status)
+	assert.ErrorIs(t, res.Err, input.ErrNoInput)
+}
+
+func Test_PuzzleVerifyExample(t *testing.T) {
+	p := Puzzle{
+		Number: 99,
+		Solver: SolverFunc(func(_ context.Context, r io.Reader) (Answer, error) {
+			lines, err := ReadLines(r)
+			if err != nil {
+				return "", err
+			}
+			if len(lines) == 0 {
+				return "", errors.New("no lines")
+			}
+			return Answerf("%d", len(lines)), nil
+		}),
+	}
+
+	res := p.VerifyExample(context.Background(), input.Example{Input: "a\nb\n", Answer: "2"})
+	assert.Equal(t, VerifyPass, res.Status)
+
+	res = p.VerifyExample(context.Background(), input.Example{Input: "a\n", Answer: "2"})
+	assert.Equal(t, VerifyFail, res.Status)
+	assert.Equal(t, Answer("1"), res.Got)
+
+	res = p.VerifyExample(context.Background(), input.Example{Input: "", Answer: "2"})
+	assert.Equal(t, VerifyError, res.Status)
+	assert.Error(t, res.Err)
+}
+
+func Test_PuzzleBench(t *testing.T) {
+	calls := 0
+	p := Puzzle{
+		Number: 99,
+		Solver: SolverFunc(func(_ context.Context, r io.Reader) (Answer, error) {
+			calls++
+			s, err := ReadString(r)
+			if err != nil {
+				return "", err
+			}
+			if s == "" {
+				return "", errors.New("empty input")
+			}
+			return Answer(strings.Repeat(s, 100)), nil
+		}),
+	}
+
+	res, err := p.Bench(context.Background(), []byte("input"), 3)
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls, "one untimed run, then the timed ones")
+	assert.Equal(t, 3, res.Runs)
+	assert.Positive(t, res.NsPerOp)
+	assert.Positive(t, res.AllocsPerOp)
+	assert.Positive(t, res.BytesPerOp)
+	assert.LessOrEqual(t, res.Fastest, res.Slowest)
+
+	_, err = p.Bench(context.Background(), nil, 3)
+	assert.EqualError(t, err, "empty input")
+
+	_, err = p.Bench(context.Background(), []byte("input"), 0)
+	assert.Error(t, err)
+}
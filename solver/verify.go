@@ -0,0 +1,110 @@
+package solver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lthummus/i18n-puzzles/input"
+)
+
+type VerifyStatus int
+
+const (
+	// VerifyPass means the solver's answer matched the expected answer
+	VerifyPass VerifyStatus = iota
+
+	// VerifyFail means the solver's answer did not match the expected answer
+	VerifyFail
+
+	// VerifyMissing means there is no expected answer to compare against. The solver is still run, so Got is filled in
+	VerifyMissing
+
+	// VerifyError means the solver (or fetching its input) returned an error
+	VerifyError
+)
+
+func (s VerifyStatus) String() string {
+	switch s {
+	case VerifyPass:
+		return "PASS"
+	case VerifyFail:
+		return "FAIL"
+	case VerifyMissing:
+		return "MISSING"
+	default:
+		return "ERROR"
+	}
+}
+
+// Regression is true for results that mean a solver that used to work doesn't any more
+func (s VerifyStatus) Regression() bool {
+	return s == VerifyFail || s == VerifyError
+}
+
+// VerifyResult is the outcome of checking one puzzle against its expected answer
+type VerifyResult struct {
+	Puzzle Puzzle
+	Kind   input.Kind
+	Status VerifyStatus
+
+	Expected Answer
+	Got      Answer
+
+	// Err is set if Status is VerifyError
+	Err error
+}
+
+// Verify runs the puzzle and compares its answer with the expected answer recorded in c
+func (p Puzzle) Verify(ctx context.Context, c *input.Client, kind input.Kind) VerifyResult {
+	res := VerifyResult{
+		Puzzle: p,
+		Kind:   kind,
+	}
+
+	expected, known, err := c.ExpectedAnswer(p.Number, kind)
+	if err != nil {
+		res.Status = VerifyError
+		res.Err = err
+		return res
+	}
+	res.Expected = Answer(expected)
+
+	res.Got, err = p.Run(ctx, c, kind)
+	switch {
+	case err != nil:
+		res.Status = VerifyError
+		res.Err = err
+	case !known:
+		res.Status = VerifyMissing
+	case res.Got == res.Expected:
+		res.Status = VerifyPass
+	default:
+		res.Status = VerifyFail
+	}
+
+	return res
+}
+
+// VerifyExample solves one of the examples from the puzzle's statement (see input.GetPuzzle) and compares the answer
+// with the one the statement gives. Examples always have an expected answer, so the result is never VerifyMissing
+func (p Puzzle) VerifyExample(ctx context.Context, ex input.Example) VerifyResult {
+	res := VerifyResult{
+		Puzzle:   p,
+		Kind:     input.TestInput,
+		Expected: Answer(ex.Answer),
+	}
+
+	var err error
+	res.Got, err = p.Solver.Solve(ctx, strings.NewReader(ex.Input))
+	switch {
+	case err != nil:
+		res.Status = VerifyError
+		res.Err = err
+	case res.Got == res.Expected:
+		res.Status = VerifyPass
+	default:
+		res.Status = VerifyFail
+	}
+
+	return res
+}
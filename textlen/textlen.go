@@ -0,0 +1,120 @@
+// Package textlen measures how long text is in the different ways that matter to different systems: bytes of UTF-8,
+// code points, UTF-16 code units (what JavaScript and Java count), extended grapheme clusters (what a person would
+// count) and Twitter's weighted length
+package textlen
+
+import (
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/lthummus/i18n-puzzles/grapheme"
+)
+
+// Unit is a way of measuring text
+type Unit int
+
+const (
+	Bytes Unit = iota
+	Runes
+	UTF16Units
+	GraphemeClusters
+	Tweet
+)
+
+func (u Unit) String() string {
+	switch u {
+	case Bytes:
+		return "bytes"
+	case Runes:
+		return "runes"
+	case UTF16Units:
+		return "UTF-16 code units"
+	case GraphemeClusters:
+		return "grapheme clusters"
+	case Tweet:
+		return "tweet weight"
+	default:
+		return fmt.Sprintf("Unit(%d)", int(u))
+	}
+}
+
+// Len measures text in the given unit
+func Len(s string, u Unit) int {
+	switch u {
+	case Bytes:
+		return len(s)
+	case Runes:
+		return utf8.RuneCountInString(s)
+	case UTF16Units:
+		return UTF16Count(s)
+	case GraphemeClusters:
+		return grapheme.Count(s)
+	case Tweet:
+		return TweetWeight(s)
+	default:
+		panic(fmt.Sprintf("textlen: Len: unknown unit %d", int(u)))
+	}
+}
+
+// UTF16Count is how many UTF-16 code units text takes, which is one for most characters and two for anything outside
+// the Basic Multilingual Plane. Invalid UTF-8 counts as U+FFFD
+func UTF16Count(s string) int {
+	count := 0
+	for _, r := range s {
+		count += utf16.RuneLen(r)
+	}
+	return count
+}
+
+// Counts is the length of one piece of text in every unit
+type Counts struct {
+	Bytes     int `json:"bytes"`
+	Runes     int `json:"runes"`
+	UTF16     int `json:"utf16"`
+	Graphemes int `json:"graphemes"`
+	Tweet     int `json:"tweet"`
+}
+
+// Count measures text in every unit at once
+func Count(s string) Counts {
+	return Counts{
+		Bytes:     len(s),
+		Runes:     utf8.RuneCountInString(s),
+		UTF16:     UTF16Count(s),
+		Graphemes: grapheme.Count(s),
+		Tweet:     TweetWeight(s),
+	}
+}
+
+// Limit is a maximum length for some text, such as a form field or a post
+type Limit struct {
+	Unit Unit
+	Max  int
+}
+
+// TweetLimit is how long a post on Twitter can be
+var TweetLimit = Limit{Unit: Tweet, Max: MaxTweetWeight}
+
+// LimitError is returned when text is longer than a Limit allows
+type LimitError struct {
+	Limit  Limit
+	Length int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("textlen: %d %s is over the limit of %d", e.Length, e.Limit.Unit, e.Limit.Max)
+}
+
+// Check returns a *LimitError if text is too long
+func (l Limit) Check(s string) error {
+	if n := Len(s, l.Unit); n > l.Max {
+		return &LimitError{Limit: l, Length: n}
+	}
+	return nil
+}
+
+// Fits is whether text is within the limit
+func (l Limit) Fits(s string) bool {
+	return l.Check(s) == nil
+}
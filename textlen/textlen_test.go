@@ -0,0 +1,71 @@
+package textlen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Count(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want Counts
+	}{
+		{name: "ascii", text: "hello", want: Counts{Bytes: 5, Runes: 5, UTF16: 5, Graphemes: 5, Tweet: 5}},
+		{name: "precomposed", text: "é", want: Counts{Bytes: 2, Runes: 1, UTF16: 1, Graphemes: 1, Tweet: 1}},
+		{name: "decomposed", text: "é", want: Counts{Bytes: 3, Runes: 2, UTF16: 2, Graphemes: 1, Tweet: 1}},
+		{name: "cjk", text: "日本語", want: Counts{Bytes: 9, Runes: 3, UTF16: 3, Graphemes: 3, Tweet: 6}},
+		{name: "astral", text: "𝄞", want: Counts{Bytes: 4, Runes: 1, UTF16: 2, Graphemes: 1, Tweet: 2}},
+		{name: "family", text: "👨‍👩‍👧‍👦", want: Counts{Bytes: 25, Runes: 7, UTF16: 11, Graphemes: 1, Tweet: 2}},
+		{name: "skin tone", text: "👍🏽", want: Counts{Bytes: 8, Runes: 2, UTF16: 4, Graphemes: 1, Tweet: 2}},
+		{name: "flags", text: "🇯🇵🇫🇷", want: Counts{Bytes: 16, Runes: 4, UTF16: 8, Graphemes: 2, Tweet: 4}},
+		{name: "keycap", text: "1️⃣", want: Counts{Bytes: 7, Runes: 3, UTF16: 3, Graphemes: 1, Tweet: 2}},
+		{name: "hangul jamo", text: "각", want: Counts{Bytes: 9, Runes: 3, UTF16: 3, Graphemes: 1, Tweet: 2}},
+		{name: "conjunct", text: "क्षि", want: Counts{Bytes: 12, Runes: 4, UTF16: 4, Graphemes: 1, Tweet: 4}},
+		{name: "crlf", text: "a\r\nb", want: Counts{Bytes: 4, Runes: 4, UTF16: 4, Graphemes: 3, Tweet: 4}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Count(tc.text))
+		})
+	}
+}
+
+func Test_TweetWeight(t *testing.T) {
+	t.Run("links count 23", func(t *testing.T) {
+		assert.Equal(t, 23, TweetWeight("https://example.com/a/very/long/path/that/goes/on/and/on"))
+		assert.Equal(t, 6+23+1, TweetWeight("go to www.example.com."))
+		assert.Equal(t, 23+1+23, TweetWeight("http://a.com http://b.com"))
+	})
+
+	t.Run("normalized first", func(t *testing.T) {
+		// one Hangul syllable made of three jamo is a single CJK character once composed
+		assert.Equal(t, 2, TweetWeight("각"))
+	})
+
+	t.Run("text symbols", func(t *testing.T) {
+		assert.Equal(t, 1, TweetWeight("©"))
+		assert.Equal(t, 2, TweetWeight("☺"))
+		assert.Equal(t, 2, TweetWeight("☺️"))
+	})
+}
+
+func Test_Limit(t *testing.T) {
+	assert.True(t, TweetLimit.Fits(strings.Repeat("a", 280)))
+	assert.False(t, TweetLimit.Fits(strings.Repeat("a", 281)))
+	assert.True(t, TweetLimit.Fits(strings.Repeat("日", 140)))
+	assert.False(t, TweetLimit.Fits(strings.Repeat("日", 141)))
+
+	err := Limit{Unit: GraphemeClusters, Max: 2}.Check("👍🏽👍🏽👍🏽")
+	var le *LimitError
+	if assert.ErrorAs(t, err, &le) {
+		assert.Equal(t, 3, le.Length)
+	}
+	assert.EqualError(t, err, "textlen: 3 grapheme clusters is over the limit of 2")
+
+	assert.Equal(t, 9, Len("日本語", Bytes))
+	assert.Equal(t, "UTF-16 code units", UTF16Units.String())
+}
@@ -0,0 +1,105 @@
+package textlen
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/lthummus/i18n-puzzles/grapheme"
+)
+
+// These are the numbers from version 3 of twitter-text's configuration, which is what decides how long a post is
+const (
+	// MaxTweetWeight is the longest a post can be. Most Latin text counts 1 per character, so this is usually thought of
+	// as 280 characters
+	MaxTweetWeight = 280
+
+	// TweetURLWeight is what every link counts as, however long it really is, since they're all shortened
+	TweetURLWeight = 23
+
+	tweetScale         = 100
+	tweetDefaultWeight = 200
+)
+
+// tweetLightRanges are the characters that only count for half as much as everything else. Anything outside of them
+// (most importantly CJK) counts 2
+var tweetLightRanges = []struct {
+	lo, hi rune
+}{
+	{0x0000, 0x10FF},
+	{0x2000, 0x200D},
+	{0x2010, 0x201F},
+	{0x2032, 0x2037},
+}
+
+// tweetURL finds links. Twitter recognizes bare domains as well, but that needs the whole list of top level domains,
+// so only links with a scheme or starting with www. are found here
+var tweetURL = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s]+`)
+
+// TweetWeight is how long text is by Twitter's rules: it's normalized to NFC, every link counts as TweetURLWeight,
+// every emoji (however many code points it's made of) counts 2, and every other code point counts 1 or 2 depending on
+// the script it's in. A post can be up to MaxTweetWeight
+func TweetWeight(s string) int {
+	s = norm.NFC.String(s)
+
+	weight := 0
+	prev := 0
+	for _, loc := range tweetURL.FindAllStringIndex(s, -1) {
+		// punctuation at the end of a sentence isn't part of the link before it
+		end := loc[0] + len(trimURL(s[loc[0]:loc[1]]))
+
+		weight += tweetTextWeight(s[prev:loc[0]])
+		weight += TweetURLWeight * tweetScale
+		prev = end
+	}
+	weight += tweetTextWeight(s[prev:])
+
+	return weight / tweetScale
+}
+
+func tweetTextWeight(s string) int {
+	weight := 0
+	for g := range grapheme.All(s) {
+		if isEmoji(g) {
+			weight += tweetDefaultWeight
+			continue
+		}
+
+		for _, r := range g {
+			weight += runeTweetWeight(r)
+		}
+	}
+
+	return weight
+}
+
+func runeTweetWeight(r rune) int {
+	for _, curr := range tweetLightRanges {
+		if r >= curr.lo && r <= curr.hi {
+			return tweetScale
+		}
+	}
+
+	return tweetDefaultWeight
+}
+
+// isEmoji is whether a grapheme cluster is an emoji: a pictograph (other than the few that are text by default and
+// don't ask to be shown as emoji), a flag or a keycap
+func isEmoji(g string) bool {
+	first, _ := utf8.DecodeRuneInString(g)
+	switch grapheme.PropertyOf(first) {
+	case grapheme.RegionalIndicator:
+		return true
+	case grapheme.ExtendedPictographic:
+		return first >= 0x1F000 || strings.ContainsRune(g, 0xFE0F)
+	}
+
+	return utf8.RuneCountInString(g) > 1 && strings.HasSuffix(g, "\u20e3")
+}
+
+// trimURL takes the punctuation that ends a sentence off the end of a link
+func trimURL(u string) string {
+	return strings.TrimRight(u, ".,:;!?'\")]")
+}
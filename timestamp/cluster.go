@@ -0,0 +1,73 @@
+package timestamp
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Event is something that happened at a particular time, such as a line of a log
+type Event struct {
+	// Line is where the event came from, such as its line number in a file
+	Line int
+
+	// Text is how the time was originally written
+	Text string
+
+	Time   time.Time
+	Format Format
+}
+
+// ParseEvent reads a timestamp in any format as an event
+func ParseEvent(line int, text string) (Event, error) {
+	t, f, err := Parse(text)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{Line: line, Text: text, Time: t, Format: f}, nil
+}
+
+// Cluster is a group of events that happened at close to the same moment
+type Cluster struct {
+	// Start and End are the times of the first and last events, in UTC
+	Start time.Time
+	End   time.Time
+
+	// Events are in order of when they happened, and then by line
+	Events []Event
+}
+
+// Coincident finds events that happened at the same moment, however their times were written. Events are grouped
+// starting from the earliest: each group takes in every following event that's within tolerance of its first one, so
+// a tolerance of 0 only groups events at exactly the same instant. The groups with at least minEvents events in them
+// are returned in order of when they started
+func Coincident(events []Event, tolerance time.Duration, minEvents int) []Cluster {
+	sorted := slices.Clone(events)
+	slices.SortStableFunc(sorted, func(a, b Event) int {
+		if c := a.Time.Compare(b.Time); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Line, b.Line)
+	})
+
+	var clusters []Cluster
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Time.Sub(sorted[start].Time) <= tolerance {
+			end++
+		}
+
+		if end-start >= minEvents {
+			clusters = append(clusters, Cluster{
+				Start:  sorted[start].Time.UTC(),
+				End:    sorted[end-1].Time.UTC(),
+				Events: sorted[start:end:end],
+			})
+		}
+
+		start = end
+	}
+
+	return clusters
+}
@@ -0,0 +1,331 @@
+// Package timestamp parses timestamps written in several common formats, working out which one each uses, and finds
+// events that happened at (nearly) the same moment
+package timestamp
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format is a way of writing a timestamp
+type Format int
+
+const (
+	// RFC3339 is 2006-01-02T15:04:05Z07:00, with or without fractional seconds
+	RFC3339 Format = iota + 1
+
+	// RFC2822 is the format used in email headers, Mon, 02 Jan 2006 15:04:05 -0700. The day of the week and the
+	// seconds are optional, and the obsolete zone names (UT, GMT, EST, EDT, CST, CDT, MST, MDT, PST and PDT) are
+	// understood
+	RFC2822
+
+	// ISOWeek is an ISO 8601 week date, 2006-W01-1 (or 2006W011), optionally followed by a time like T15:04:05Z07:00
+	ISOWeek
+
+	// ISOOrdinal is an ISO 8601 ordinal date, 2006-002, optionally followed by a time like T15:04:05Z07:00
+	ISOOrdinal
+
+	// Unix is a count of seconds since 1970-01-01 UTC, optionally with a fraction. Counts with 12 or more digits are
+	// taken to be milliseconds, 15 or more microseconds and 18 or more nanoseconds. A count with no sign or fraction
+	// needs at least 9 digits (anything since March 1973), since shorter ones are just as likely to be a year (2019) or
+	// an ISO 8601 basic date (20190605 or 2019156)
+	Unix
+)
+
+func (f Format) String() string {
+	switch f {
+	case RFC3339:
+		return "RFC 3339"
+	case RFC2822:
+		return "RFC 2822"
+	case ISOWeek:
+		return "ISO 8601 week date"
+	case ISOOrdinal:
+		return "ISO 8601 ordinal date"
+	case Unix:
+		return "Unix time"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// ErrUnknownFormat means a timestamp doesn't look like any of the formats
+var ErrUnknownFormat = errors.New("timestamp: unknown format")
+
+var (
+	rfc3339Pattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)
+	weekPattern    = regexp.MustCompile(`^(\d{4})-?W(\d{2})-?([1-7])(?:T(.+))?$`)
+	ordinalPattern = regexp.MustCompile(`^(\d{4})-(\d{3})(?:T(.+))?$`)
+	unixPattern    = regexp.MustCompile(`^(-?)(\d+)(?:\.(\d{1,9}))?$`)
+	clockPattern   = regexp.MustCompile(`^(\d{2}):(\d{2})(?::(\d{2})(?:[.,](\d{1,9}))?)?(Z|[+-]\d{2}(?::?\d{2})?)?$`)
+
+	// rfc2822Pattern is how a timestamp that might be RFC 2822 starts: the day of the week, or the day of the month
+	// followed by the name of a month
+	rfc2822Pattern = regexp.MustCompile(`^(?:[A-Za-z]{3},\s*)?\d{1,2}\s+[A-Za-z]{3}\s`)
+)
+
+// minUnixDigits is how many digits a bare count of seconds (with no sign or fraction) needs to be taken as Unix time
+const minUnixDigits = 9
+
+// Detect works out which format a timestamp is in from what it looks like, without checking that it's valid
+func Detect(s string) (Format, error) {
+	s = strings.TrimSpace(s)
+
+	switch {
+	case isUnix(s):
+		return Unix, nil
+	case rfc3339Pattern.MatchString(s):
+		return RFC3339, nil
+	case weekPattern.MatchString(s):
+		return ISOWeek, nil
+	case ordinalPattern.MatchString(s):
+		return ISOOrdinal, nil
+	case rfc2822Pattern.MatchString(s):
+		return RFC2822, nil
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownFormat, s)
+}
+
+// isUnix reports whether s looks like Unix time. See Unix for why short numbers on their own don't count
+func isUnix(s string) bool {
+	m := unixPattern.FindStringSubmatch(s)
+	if m == nil {
+		return false
+	}
+
+	sign, digits, fraction := m[1], m[2], m[3]
+	return sign != "" || fraction != "" || len(digits) >= minUnixDigits
+}
+
+// Parse reads a timestamp in any of the formats, returning it along with the format it was in
+func Parse(s string) (time.Time, Format, error) {
+	f, err := Detect(s)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	t, err := ParseFormat(s, f)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	return t, f, nil
+}
+
+// ParseFormat reads a timestamp that's in a particular format. Timestamps without an offset from UTC (which only ISO
+// week and ordinal dates can leave out) are taken to be in UTC
+func ParseFormat(s string, f Format) (time.Time, error) {
+	s = strings.TrimSpace(s)
+
+	var t time.Time
+	var err error
+	switch f {
+	case RFC3339:
+		t, err = time.Parse(time.RFC3339Nano, s)
+	case RFC2822:
+		t, err = parseRFC2822(s)
+	case ISOWeek:
+		t, err = parseWeek(s)
+	case ISOOrdinal:
+		t, err = parseOrdinal(s)
+	case Unix:
+		t, err = parseUnix(s)
+	default:
+		return time.Time{}, fmt.Errorf("timestamp: ParseFormat: unknown format %d", int(f))
+	}
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("timestamp: %q isn't a valid %s: %w", s, f, err)
+	}
+
+	return t, nil
+}
+
+// obsoleteZones are the zone names RFC 2822 still allows. Go would make up a zone with no offset for any name it
+// doesn't know about locally, so these are swapped for their offsets before parsing and anything else is rejected
+var obsoleteZones = map[string]string{
+	"UT":  "+0000",
+	"GMT": "+0000",
+	"Z":   "+0000",
+	"EST": "-0500",
+	"EDT": "-0400",
+	"CST": "-0600",
+	"CDT": "-0500",
+	"MST": "-0700",
+	"MDT": "-0600",
+	"PST": "-0800",
+	"PDT": "-0700",
+}
+
+func parseRFC2822(s string) (time.Time, error) {
+	i := strings.LastIndexByte(s, ' ')
+	if i < 0 {
+		return time.Time{}, fmt.Errorf("no zone")
+	}
+
+	zone := s[i+1:]
+	if zone[0] != '+' && zone[0] != '-' {
+		offset, ok := obsoleteZones[strings.ToUpper(zone)]
+		if !ok {
+			return time.Time{}, fmt.Errorf("unknown zone %q", zone)
+		}
+		s = s[:i+1] + offset
+	}
+
+	return mail.ParseDate(s)
+}
+
+func parseWeek(s string) (time.Time, error) {
+	m := weekPattern.FindStringSubmatch(s)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("expected YYYY-Www-D")
+	}
+
+	year, _ := strconv.Atoi(m[1])
+	week, _ := strconv.Atoi(m[2])
+	day, _ := strconv.Atoi(m[3])
+
+	if week < 1 || week > weeksInYear(year) {
+		return time.Time{}, fmt.Errorf("%d has no week %d", year, week)
+	}
+
+	// week 1 is the one with the year's first Thursday in it, which is always the one with the 4th of January
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := 4 - (int(jan4.Weekday())+6)%7
+
+	return atClock(year, time.January, monday+(week-1)*7+day-1, m[4])
+}
+
+// weeksInYear is how many ISO weeks a year has. Years that end on a Thursday (or on a Friday in leap years) have 53
+func weeksInYear(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+func parseOrdinal(s string) (time.Time, error) {
+	m := ordinalPattern.FindStringSubmatch(s)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("expected YYYY-DDD")
+	}
+
+	year, _ := strconv.Atoi(m[1])
+	day, _ := strconv.Atoi(m[2])
+
+	days := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	if day < 1 || day > days {
+		return time.Time{}, fmt.Errorf("%d has no day %d", year, day)
+	}
+
+	return atClock(year, time.January, day, m[3])
+}
+
+// atClock is a date, which may be given as a day of the month past the end of the month, at a time of day written
+// as ISO 8601 (15:04:05.999Z07:00, where only the hour and minute are required). No time at all is midnight UTC
+func atClock(year int, month time.Month, day int, clock string) (time.Time, error) {
+	if clock == "" {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+	}
+
+	m := clockPattern.FindStringSubmatch(clock)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", clock)
+	}
+
+	hour, _ := strconv.Atoi(m[1])
+	minute, _ := strconv.Atoi(m[2])
+	second := 0
+	if m[3] != "" {
+		second, _ = strconv.Atoi(m[3])
+	}
+	if hour > 23 || minute > 59 || second > 59 {
+		return time.Time{}, fmt.Errorf("invalid time %q", clock)
+	}
+
+	nanos := 0
+	if m[4] != "" {
+		nanos, _ = strconv.Atoi((m[4] + "00000000")[:9])
+	}
+
+	loc := time.UTC
+	if zone := m[5]; zone != "" && zone != "Z" {
+		offset, err := parseOffset(zone)
+		if err != nil {
+			return time.Time{}, err
+		}
+		loc = time.FixedZone("", offset)
+	}
+
+	return time.Date(year, month, day, hour, minute, second, nanos, loc), nil
+}
+
+// parseOffset reads an offset from UTC like +05, +0530 or +05:30, returning it in seconds
+func parseOffset(zone string) (int, error) {
+	digits := strings.ReplaceAll(zone[1:], ":", "")
+
+	hours, _ := strconv.Atoi(digits[:2])
+	minutes := 0
+	if len(digits) > 2 {
+		minutes, _ = strconv.Atoi(digits[2:])
+	}
+	if hours > 23 || minutes > 59 {
+		return 0, fmt.Errorf("invalid offset %q", zone)
+	}
+
+	offset := (hours*60 + minutes) * 60
+	if zone[0] == '-' {
+		offset = -offset
+	}
+
+	return offset, nil
+}
+
+func parseUnix(s string) (time.Time, error) {
+	m := unixPattern.FindStringSubmatch(s)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("expected a number")
+	}
+
+	negative, whole, fraction := m[1] == "-", m[2], m[3]
+
+	// how many digits of the whole number are smaller than a second
+	scale := 0
+	switch {
+	case len(whole) >= 18:
+		scale = 9
+	case len(whole) >= 15:
+		scale = 6
+	case len(whole) >= 12:
+		scale = 3
+	}
+	if scale > 0 && fraction != "" {
+		return time.Time{}, fmt.Errorf("only whole seconds can have a fraction")
+	}
+
+	// split the number in to whole seconds and nanoseconds as text, so nothing is lost to floating point
+	if scale > 0 {
+		fraction = whole[len(whole)-scale:]
+		whole = whole[:len(whole)-scale]
+	}
+
+	seconds, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	nanos := int64(0)
+	if fraction != "" {
+		nanos, _ = strconv.ParseInt((fraction + "00000000")[:9], 10, 64)
+	}
+
+	if negative {
+		seconds, nanos = -seconds, -nanos
+	}
+
+	return time.Unix(seconds, nanos).UTC(), nil
+}
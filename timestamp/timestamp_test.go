@@ -0,0 +1,133 @@
+package timestamp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	want := time.Date(2019, time.June, 5, 8, 15, 0, 0, time.UTC)
+
+	tests := []struct {
+		text   string
+		format Format
+		want   time.Time
+	}{
+		{text: "2019-06-05T08:15:00Z", format: RFC3339, want: want},
+		{text: "2019-06-05T04:15:00-04:00", format: RFC3339, want: want},
+		{text: "2019-06-05T08:15:00.25+00:00", format: RFC3339, want: want.Add(250 * time.Millisecond)},
+		{text: "Wed, 05 Jun 2019 10:15:00 +0200", format: RFC2822, want: want},
+		{text: "5 Jun 2019 04:15 EDT", format: RFC2822, want: want},
+		{text: "Wed, 5 Jun 2019 08:15:00 GMT", format: RFC2822, want: want},
+		{text: "2019-W23-3T08:15:00Z", format: ISOWeek, want: want},
+		{text: "2019W233T13:45+05:30", format: ISOWeek, want: want},
+		{text: "2019-W23-3", format: ISOWeek, want: want.Truncate(24 * time.Hour)},
+		{text: "2019-156T08:15:00Z", format: ISOOrdinal, want: want},
+		{text: "2019-156T03:15:00,000-05", format: ISOOrdinal, want: want},
+		{text: "1559722500", format: Unix, want: want},
+		{text: "1559722500.5", format: Unix, want: want.Add(500 * time.Millisecond)},
+		{text: "1559722500500", format: Unix, want: want.Add(500 * time.Millisecond)},
+		{text: "1559722500000001", format: Unix, want: want.Add(time.Microsecond)},
+		{text: "1559722500000000001", format: Unix, want: want.Add(time.Nanosecond)},
+		{text: "-1.5", format: Unix, want: time.Unix(-1, -500_000_000)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.text, func(t *testing.T) {
+			got, format, err := Parse(tc.text)
+			require.NoError(t, err)
+			assert.Equal(t, tc.format, format)
+			assert.True(t, tc.want.Equal(got), "expected %s, got %s", tc.want, got)
+		})
+	}
+}
+
+func Test_ParseWeekDates(t *testing.T) {
+	// the edges of ISO years don't line up with calendar years
+	tests := map[string]string{
+		"2004-W53-6": "2005-01-01",
+		"2005-W01-1": "2005-01-03",
+		"2008-W01-1": "2007-12-31",
+		"2009-W53-7": "2010-01-03",
+		"2020-366":   "2020-12-31",
+	}
+
+	for text, date := range tests {
+		got, _, err := Parse(text)
+		if assert.NoError(t, err, text) {
+			assert.Equal(t, date, got.Format(time.DateOnly), text)
+		}
+	}
+}
+
+func Test_ParseErrors(t *testing.T) {
+	for _, text := range []string{"", "yesterday", "2019-06-05", "05/06/2019 08:15", "2019", "20190605", "2019156", "12345678"} {
+		_, _, err := Parse(text)
+		assert.ErrorIs(t, err, ErrUnknownFormat, text)
+	}
+
+	for _, text := range []string{"2005-W53-1", "2019-366", "2019-000", "2019-W23-3T25:00Z", "2019-06-05T08:15:00", "Wed, 05 Jun 2019 10:15:00 XYZ", "1559722500500.5"} {
+		_, _, err := Parse(text)
+		assert.Error(t, err, text)
+		assert.NotErrorIs(t, err, ErrUnknownFormat, text)
+	}
+
+	_, err := ParseFormat("1559722500", RFC3339)
+	assert.Error(t, err)
+}
+
+func Test_Coincident(t *testing.T) {
+	var events []Event
+	for i, text := range []string{
+		"2019-06-05T08:15:00Z",
+		"1559722500",
+		"2019-06-05T08:15:01Z",
+		"2001-01-01T00:00:00Z",
+		"Wed, 05 Jun 2019 10:15:00 +0200",
+		"978307200",
+		"2019-156T08:15:02Z",
+	} {
+		e, err := ParseEvent(i+1, text)
+		require.NoError(t, err)
+		events = append(events, e)
+	}
+
+	t.Run("exact", func(t *testing.T) {
+		clusters := Coincident(events, 0, 2)
+		require.Len(t, clusters, 2)
+
+		assert.Equal(t, "2001-01-01T00:00:00Z", clusters[0].Start.Format(time.RFC3339))
+		assert.Len(t, clusters[0].Events, 2)
+
+		assert.Equal(t, "2019-06-05T08:15:00Z", clusters[1].Start.Format(time.RFC3339))
+		assert.Equal(t, clusters[1].Start, clusters[1].End)
+		lines := []int{}
+		for _, e := range clusters[1].Events {
+			lines = append(lines, e.Line)
+		}
+		assert.Equal(t, []int{1, 2, 5}, lines)
+		assert.Equal(t, RFC2822, clusters[1].Events[2].Format)
+	})
+
+	t.Run("tolerance", func(t *testing.T) {
+		clusters := Coincident(events, time.Second, 3)
+		require.Len(t, clusters, 1)
+		assert.Len(t, clusters[0].Events, 4)
+		assert.Equal(t, time.Second, clusters[0].End.Sub(clusters[0].Start))
+
+		// the event 2 seconds in is too far from the first, so it starts a group of its own
+		clusters = Coincident(events, time.Second, 1)
+		require.Len(t, clusters, 3)
+		assert.Equal(t, 7, clusters[2].Events[0].Line)
+
+		assert.Len(t, Coincident(events, 2*time.Second, 5), 1)
+	})
+
+	t.Run("threshold", func(t *testing.T) {
+		assert.Empty(t, Coincident(events, 0, 4))
+		assert.Empty(t, Coincident(nil, 0, 1))
+	})
+}